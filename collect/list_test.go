@@ -2,9 +2,11 @@ package collect_test
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/apitalist/collections/collect"
 	"github.com/apitalist/collections/stream"
+	"github.com/apitalist/lang"
 )
 
 func ExampleToList() {
@@ -18,3 +20,26 @@ func ExampleToList() {
 
 	// Output: 0
 }
+
+func ExampleToList_error() {
+	s := stream.Map(
+		stream.Of("1", "2", "three"),
+		func(s string) int {
+			i, err := strconv.Atoi(s)
+			if err != nil {
+				panic(err)
+			}
+			return i
+		},
+	)
+
+	// Errors in the stream are thrown in a panic instead of returning a partial list:
+	err := lang.Safe(
+		func() {
+			collect.ToList(s)
+		},
+	)
+	fmt.Println(err)
+
+	// Output: stream stage #1 Map failed at element 2 (strconv.Atoi: parsing "three": invalid syntax)
+}
@@ -1,5 +1,7 @@
 package collections
 
+import "iter"
+
 // Collection is a generic type that can hold a collection of items. It does not enforce any specific ordering, or the
 // ability to modify the collection, only a few simple elements.
 //
@@ -22,6 +24,15 @@ type Collection[E comparable] interface {
 
 	// Stream creates a functional stream that you can use to filter, map, and process the elements in this collection.
 	Stream() Stream[E]
+
+	// All returns an iterator function over the elements of the collection for use in a for-range loop:
+	//
+	//     for element := range collection.All() {
+	//         // Use element here
+	//     }
+	//
+	// The elements are returned in the same order as Iterator() would return them.
+	All() iter.Seq[E]
 }
 
 // MutableCollection is a collection variant that allows directly changing the elements of the current collection.
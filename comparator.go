@@ -1,6 +1,103 @@
 package collections
 
-// Comparator is a function that compares two values. If the first parameter is larger than the second parameter, a
-// negative number is returned. If the second parameter is larger, a positive number is returned. If they are equal,
-// zero is returned.
+import (
+	"cmp"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NewComparator creates a comparator from the specified function.
+func NewComparator[E any](f func(a, b E) int) Comparator[E] {
+	return f
+}
+
+// Comparator is a function that compares two values. If the first parameter should be ordered before the second
+// parameter (it is smaller), a negative number is returned. If the first parameter should be ordered after the second
+// parameter (it is larger), a positive number is returned. If they are equal, zero is returned. Converting a simple
+// function to a comparator offers the ability to combine it with other comparators, for example to sort by multiple
+// keys.
 type Comparator[E any] func(a, b E) int
+
+// Reversed creates a new comparator that orders elements in the reverse order of the current comparator.
+func (c Comparator[E]) Reversed() Comparator[E] {
+	return func(a, b E) int {
+		return c(b, a)
+	}
+}
+
+// ThenComparing creates a new comparator that uses the passed comparator to order elements the current comparator
+// considers equal.
+func (c Comparator[E]) ThenComparing(other Comparator[E]) Comparator[E] {
+	return func(a, b E) int {
+		if result := c(a, b); result != 0 {
+			return result
+		}
+		return other(a, b)
+	}
+}
+
+// Natural returns a comparator that orders elements in their natural order, from smallest to largest. Strings are
+// ordered lexically byte-wise, floating point NaN values are ordered before all other values.
+func Natural[E cmp.Ordered]() Comparator[E] {
+	return cmp.Compare[E]
+}
+
+// Comparing creates a comparator that orders elements by the key extracted from each element using the passed
+// comparator. For example, to sort people by their age:
+//
+//     collections.Comparing(
+//         func(p person) int {
+//             return p.age
+//         },
+//         collections.Natural[int](),
+//     )
+func Comparing[E any, K any](key func(E) K, comparator Comparator[K]) Comparator[E] {
+	return func(a, b E) int {
+		return comparator(key(a), key(b))
+	}
+}
+
+// NilsFirst creates a comparator for pointers that orders nil pointers before all other pointers, and uses the passed
+// comparator to order the values of non-nil pointers.
+func NilsFirst[E any](comparator Comparator[E]) Comparator[*E] {
+	return nils(comparator, -1)
+}
+
+// NilsLast creates a comparator for pointers that orders nil pointers after all other pointers, and uses the passed
+// comparator to order the values of non-nil pointers.
+func NilsLast[E any](comparator Comparator[E]) Comparator[*E] {
+	return nils(comparator, 1)
+}
+
+// nils creates a pointer comparator that returns nilResult if only the first pointer is nil.
+func nils[E any](comparator Comparator[E], nilResult int) Comparator[*E] {
+	return func(a, b *E) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return nilResult
+		case b == nil:
+			return -nilResult
+		default:
+			return comparator(*a, *b)
+		}
+	}
+}
+
+// CaseInsensitive returns a comparator that orders strings lexically, ignoring the case of letters. Strings that only
+// differ in case are considered equal.
+func CaseInsensitive() Comparator[string] {
+	return func(a, b string) int {
+		for a != "" && b != "" {
+			runeA, sizeA := utf8.DecodeRuneInString(a)
+			runeB, sizeB := utf8.DecodeRuneInString(b)
+			if result := cmp.Compare(unicode.ToLower(runeA), unicode.ToLower(runeB)); result != 0 {
+				return result
+			}
+			a = a[sizeA:]
+			b = b[sizeB:]
+		}
+		return cmp.Compare(len(a), len(b))
+	}
+}
@@ -0,0 +1,93 @@
+package collections_test
+
+import (
+	"fmt"
+
+	"github.com/apitalist/collections"
+	"github.com/apitalist/collections/slice"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func ExampleComparator() {
+	people := slice.New(
+		person{"Carol", 32},
+		person{"alice", 45},
+		person{"Bob", 32},
+	)
+
+	// Sort by age, oldest first, then by name:
+	people.Sort(
+		collections.Comparing(
+			func(p person) int {
+				return p.age
+			},
+			collections.Natural[int](),
+		).Reversed().ThenComparing(
+			collections.Comparing(
+				func(p person) string {
+					return p.name
+				},
+				collections.CaseInsensitive(),
+			),
+		),
+	)
+	fmt.Println(people)
+
+	// Output: [{alice 45}, {Bob 32}, {Carol 32}]
+}
+
+func ExampleNatural() {
+	list := slice.New(3, 1, 2)
+	list.Sort(collections.Natural[int]())
+	fmt.Println(list)
+
+	// Output: [1, 2, 3]
+}
+
+func ExampleCaseInsensitive() {
+	list := slice.New("b", "C", "a", "B")
+	list.Sort(collections.CaseInsensitive())
+	fmt.Println(list)
+
+	// Output: [a, b, B, C]
+}
+
+func ExampleNilsFirst() {
+	one, two := 1, 2
+	list := slice.New(&two, nil, &one)
+
+	list.Sort(collections.NilsFirst(collections.Natural[int]()))
+	for _, e := range list.ToSlice() {
+		if e == nil {
+			fmt.Println("nil")
+		} else {
+			fmt.Println(*e)
+		}
+	}
+
+	// Output: nil
+	// 1
+	// 2
+}
+
+func ExampleNilsLast() {
+	one, two := 1, 2
+	list := slice.New(&two, nil, &one)
+
+	list.Sort(collections.NilsLast(collections.Natural[int]().Reversed()))
+	for _, e := range list.ToSlice() {
+		if e == nil {
+			fmt.Println("nil")
+		} else {
+			fmt.Println(*e)
+		}
+	}
+
+	// Output: 2
+	// 1
+	// nil
+}
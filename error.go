@@ -11,3 +11,69 @@ var ErrElementNotFound = fmt.Errorf("element not found")
 
 // ErrKeyNotFound indicates that the specified key in a map was not found.
 var ErrKeyNotFound = fmt.Errorf("key not found")
+
+// ErrStreamConsumed indicates that a stream has already been used by a terminal or intermediate element, or has been
+// closed. Streams can only be used once.
+var ErrStreamConsumed = fmt.Errorf("stream has already been consumed or closed")
+
+// ErrConcurrentModification indicates that a collection has been modified while it was being iterated over, other than
+// through the Remove() function of the iterator itself.
+var ErrConcurrentModification = fmt.Errorf("concurrent modification")
+
+// ErrUnsupportedOperation indicates that the called function is not supported by the implementation, for example
+// modifying an immutable list through an iterator.
+var ErrUnsupportedOperation = fmt.Errorf("unsupported operation")
+
+// IndexOutOfBoundsError is thrown in a panic when an index is outside the bounds of a list or an iterator ran out of
+// elements. It matches ErrIndexOutOfBounds when used with errors.Is.
+type IndexOutOfBoundsError struct {
+	// Index is the index that was requested. It is -1 if the element before the start of a list was requested, for
+	// example by calling Previous() on a ListIterator at the start of the list.
+	Index int
+	// Size is the number of elements the index was checked against.
+	Size uint
+}
+
+// Error returns the error message including the requested index and the size.
+func (e IndexOutOfBoundsError) Error() string {
+	return fmt.Sprintf("%v: index %d, size %d", ErrIndexOutOfBounds, e.Index, e.Size)
+}
+
+// Is returns true if the target is ErrIndexOutOfBounds.
+func (e IndexOutOfBoundsError) Is(target error) bool {
+	return target == ErrIndexOutOfBounds
+}
+
+// ElementNotFoundError is thrown in a panic when an IndexOf or similar operation did not find the specified element. It
+// matches ErrElementNotFound when used with errors.Is.
+type ElementNotFoundError struct {
+	// Element is the element that was not found.
+	Element any
+}
+
+// Error returns the error message including the element that was not found.
+func (e ElementNotFoundError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrElementNotFound, e.Element)
+}
+
+// Is returns true if the target is ErrElementNotFound.
+func (e ElementNotFoundError) Is(target error) bool {
+	return target == ErrElementNotFound
+}
+
+// KeyNotFoundError is thrown in a panic when the specified key in a map was not found. It matches ErrKeyNotFound when
+// used with errors.Is.
+type KeyNotFoundError struct {
+	// Key is the key that was not found.
+	Key any
+}
+
+// Error returns the error message including the key that was not found.
+func (e KeyNotFoundError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrKeyNotFound, e.Key)
+}
+
+// Is returns true if the target is ErrKeyNotFound.
+func (e KeyNotFoundError) Is(target error) bool {
+	return target == ErrKeyNotFound
+}
@@ -0,0 +1,45 @@
+package collections_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/apitalist/collections"
+	"github.com/apitalist/collections/slice"
+	"github.com/apitalist/lang"
+)
+
+func ExampleIndexOutOfBoundsError() {
+	list := slice.New("a", "b", "c")
+
+	err := lang.Safe(
+		func() {
+			list.RemoveAt(5)
+		},
+	)
+
+	var indexErr collections.IndexOutOfBoundsError
+	if errors.As(err, &indexErr) {
+		fmt.Printf("Index %d requested from a list of size %d.\n", indexErr.Index, indexErr.Size)
+	}
+	fmt.Println(errors.Is(err, collections.ErrIndexOutOfBounds))
+
+	// Output: Index 5 requested from a list of size 3.
+	// true
+}
+
+func ExampleElementNotFoundError() {
+	list := slice.New("a", "b", "c")
+
+	err := lang.Safe(
+		func() {
+			list.IndexOf("d")
+		},
+	)
+
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, collections.ErrElementNotFound))
+
+	// Output: element not found: d
+	// true
+}
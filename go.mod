@@ -1,5 +1,5 @@
 module github.com/apitalist/collections
 
-go 1.18
+go 1.23
 
 require github.com/apitalist/lang v1.2.1
@@ -4,6 +4,7 @@ package immutableslice
 
 import (
 	"fmt"
+	"iter"
 	"sort"
 	"strings"
 	"sync"
@@ -64,11 +65,43 @@ func (s slice[E]) Stream() collections.Stream[E] {
 func (s slice[E]) Iterator() collections.Iterator[E] {
 	return &sliceIterator[E]{
 		slice: s,
-		index: -1,
 		lock:  &sync.Mutex{},
 	}
 }
 
+// ListIterator returns a read-only iterator that can move in both directions. Calling Remove, Set or Add on the
+// iterator panics with a collections.ErrUnsupportedOperation.
+func (s slice[E]) ListIterator(start uint) collections.ListIterator[E] {
+	if start > uint(len(s.data)) {
+		panic(collections.IndexOutOfBoundsError{Index: int(start), Size: uint(len(s.data))})
+	}
+	return &sliceIterator[E]{
+		slice:  s,
+		cursor: int(start),
+		lock:   &sync.Mutex{},
+	}
+}
+
+func (s slice[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, e := range s.data {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+func (s slice[E]) Backward() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for i := len(s.data) - 1; i >= 0; i-- {
+			if !yield(s.data[i]) {
+				return
+			}
+		}
+	}
+}
+
 func (s slice[E]) Contains(e E) bool {
 	for _, entry := range s.data {
 		if e == entry {
@@ -93,34 +126,62 @@ func (s slice[E]) ToSlice() []E {
 }
 
 func (s slice[E]) Get(index uint) E {
+	e, ok := s.TryGet(index)
+	if !ok {
+		panic(collections.IndexOutOfBoundsError{Index: int(index), Size: uint(len(s.data))})
+	}
+	return e
+}
+
+func (s slice[E]) TryGet(index uint) (E, bool) {
 	if index >= uint(len(s.data)) {
-		panic(collections.ErrIndexOutOfBounds)
+		var defaultValue E
+		return defaultValue, false
 	}
-	return s.data[index]
+	return s.data[index], true
 }
 
 func (s slice[E]) IndexOf(e E) uint {
+	index, ok := s.Find(e)
+	if !ok {
+		panic(collections.ElementNotFoundError{Element: e})
+	}
+	return index
+}
+
+func (s slice[E]) Find(e E) (uint, bool) {
 	for i, entry := range s.data {
 		if e == entry {
-			return uint(i)
+			return uint(i), true
 		}
 	}
-	panic(collections.ErrElementNotFound)
+	return 0, false
 }
 
 func (s slice[E]) LastIndexOf(e E) uint {
+	index, ok := s.FindLast(e)
+	if !ok {
+		panic(collections.ElementNotFoundError{Element: e})
+	}
+	return index
+}
+
+func (s slice[E]) FindLast(e E) (uint, bool) {
 	for i := len(s.data) - 1; i >= 0; i-- {
 		elem := (s.data)[i]
 		if elem == e {
-			return uint(i)
+			return uint(i), true
 		}
 	}
-	panic(collections.ErrElementNotFound)
+	return 0, false
 }
 
 func (s slice[E]) SubList(from, to uint) collections.ImmutableList[E] {
-	if from > to || to >= uint(len(s.data)) {
-		panic(collections.ErrIndexOutOfBounds)
+	if from > to {
+		panic(collections.IndexOutOfBoundsError{Index: int(from), Size: uint(len(s.data))})
+	}
+	if to > uint(len(s.data)) {
+		panic(collections.IndexOutOfBoundsError{Index: int(to), Size: uint(len(s.data))})
 	}
 	return slice[E]{
 		s.data[from:to],
@@ -204,7 +265,7 @@ func (s slice[E]) WithRetainedAll(c collections.Collection[E]) collections.Immut
 
 func (s slice[E]) WithAddedAt(index uint, element E) collections.ImmutableList[E] {
 	if index > uint(len(s.data)) {
-		panic(collections.ErrIndexOutOfBounds)
+		panic(collections.IndexOutOfBoundsError{Index: int(index), Size: uint(len(s.data))})
 	}
 	newSlice := make([]E, len(s.data)+1)
 	copy(newSlice[:index], s.data[:index])
@@ -217,7 +278,7 @@ func (s slice[E]) WithAddedAt(index uint, element E) collections.ImmutableList[E
 
 func (s slice[E]) WithSet(index uint, element E) collections.ImmutableList[E] {
 	if index >= uint(len(s.data)) {
-		panic(collections.ErrIndexOutOfBounds)
+		panic(collections.IndexOutOfBoundsError{Index: int(index), Size: uint(len(s.data))})
 	}
 	newSlice := make([]E, len(s.data))
 	copy(newSlice, s.data)
@@ -242,7 +303,7 @@ func (s slice[E]) WithSorted(c collections.Comparator[E]) collections.ImmutableL
 
 func (s slice[E]) WithRemovedAt(index uint) collections.ImmutableList[E] {
 	if index >= uint(len(s.data)) {
-		panic(collections.ErrIndexOutOfBounds)
+		panic(collections.IndexOutOfBoundsError{Index: int(index), Size: uint(len(s.data))})
 	}
 	newSlice := make([]E, len(s.data)-1)
 	copy(newSlice[:index], s.data[:index])
@@ -262,15 +323,16 @@ func (s slice[E]) String() string {
 
 type sliceIterator[E comparable] struct {
 	slice slice[E]
-	index int
-	lock  *sync.Mutex
+	// cursor is the index of the element returned by the next call to Next().
+	cursor int
+	lock   *sync.Mutex
 }
 
 func (s *sliceIterator[E]) ForEachRemaining(c collections.Consumer[E]) {
 	s.lock.Lock()
-	for s.index < len(s.slice.data)-1 {
-		element := s.slice.data[s.index]
-		s.index++
+	for s.cursor < len(s.slice.data) {
+		element := s.slice.data[s.cursor]
+		s.cursor++
 		s.lock.Unlock()
 		c(element)
 		s.lock.Lock()
@@ -281,15 +343,56 @@ func (s *sliceIterator[E]) ForEachRemaining(c collections.Consumer[E]) {
 func (s sliceIterator[E]) HasNext() bool {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	return s.index < len(s.slice.data)-1
+	return s.cursor < len(s.slice.data)
 }
 
 func (s *sliceIterator[E]) Next() E {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	if s.index >= len(s.slice.data)-1 {
-		panic(collections.ErrIndexOutOfBounds)
+	if s.cursor >= len(s.slice.data) {
+		panic(collections.IndexOutOfBoundsError{Index: s.cursor, Size: uint(len(s.slice.data))})
 	}
-	s.index++
-	return s.slice.data[s.index]
+	s.cursor++
+	return s.slice.data[s.cursor-1]
+}
+
+func (s sliceIterator[E]) HasPrevious() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.cursor > 0
+}
+
+func (s *sliceIterator[E]) Previous() E {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.cursor <= 0 {
+		panic(collections.IndexOutOfBoundsError{Index: s.cursor - 1, Size: uint(len(s.slice.data))})
+	}
+	s.cursor--
+	return s.slice.data[s.cursor]
+}
+
+func (s sliceIterator[E]) NextIndex() uint {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return uint(s.cursor)
+}
+
+func (s sliceIterator[E]) PreviousIndex() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.cursor - 1
+}
+
+// Remove, Set and Add are not supported on immutable slices and panic with a collections.ErrUnsupportedOperation.
+func (s *sliceIterator[E]) Remove() {
+	panic(collections.ErrUnsupportedOperation)
+}
+
+func (s *sliceIterator[E]) Set(_ E) {
+	panic(collections.ErrUnsupportedOperation)
+}
+
+func (s *sliceIterator[E]) Add(_ E) {
+	panic(collections.ErrUnsupportedOperation)
 }
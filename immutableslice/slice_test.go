@@ -1,10 +1,13 @@
 package immutableslice_test
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/apitalist/collections"
 	"github.com/apitalist/collections/immutableslice"
+	"github.com/apitalist/lang"
 )
 
 func Example() {
@@ -124,6 +127,24 @@ func ExampleImmutableSlice_lastIndexOf() {
 	// Output: 'b' is last in position 3
 }
 
+func ExampleImmutableSlice_find() {
+	s := immutableslice.New("a", "b", "c", "b", "a")
+
+	if i, ok := s.Find("b"); ok {
+		fmt.Printf("'b' is in position %d\n", i)
+	}
+	if i, ok := s.FindLast("b"); ok {
+		fmt.Printf("'b' is last in position %d\n", i)
+	}
+	if _, ok := s.Find("d"); !ok {
+		fmt.Println("'d' is not in the list")
+	}
+
+	// Output: 'b' is in position 1
+	// 'b' is last in position 3
+	// 'd' is not in the list
+}
+
 func ExampleImmutableSlice_withSet() {
 	// Create a new immutable slice:
 	s := immutableslice.New("a", "b", "c")
@@ -228,6 +249,20 @@ func ExampleImmutableSlice_get() {
 	// Output: b
 }
 
+func ExampleImmutableSlice_tryGet() {
+	// Create a new immutable slice:
+	s := immutableslice.New("a", "b", "c")
+
+	// Get an item without panicking:
+	if item, ok := s.TryGet(5); ok {
+		fmt.Println(item)
+	} else {
+		fmt.Println("There is no item at index 5.")
+	}
+
+	// Output: There is no item at index 5.
+}
+
 func ExampleNew() {
 	// Create a new immutable slice using the New function:
 	s := immutableslice.New("a", "b", "c") //nolint:ineffassign
@@ -244,3 +279,67 @@ func ExampleNew() {
 
 	// Output: [d, e]
 }
+
+func ExampleImmutableSlice_listIterator() {
+	s := immutableslice.New("a", "b", "c")
+
+	iterator := s.ListIterator(1)
+	fmt.Println(iterator.Next())
+	fmt.Println(iterator.Previous())
+	fmt.Println(iterator.Previous())
+
+	// The list iterator of an immutable slice is read-only:
+	err := lang.Safe(
+		func() {
+			iterator.Set("d")
+		},
+	)
+	fmt.Println(err)
+
+	// Output: b
+	// b
+	// a
+	// unsupported operation
+}
+
+func ExampleImmutableSlice_listIteratorOutOfBounds() {
+	s := immutableslice.New("a", "b", "c")
+
+	// Moving before the start of the slice results in an error:
+	err := lang.Safe(
+		func() {
+			_ = s.ListIterator(0).Previous()
+		},
+	)
+	var indexErr collections.IndexOutOfBoundsError
+	fmt.Println(errors.As(err, &indexErr), err)
+
+	// Output: true index out of bounds: index -1, size 3
+}
+
+func ExampleImmutableSlice_all() {
+	s := immutableslice.New("a", "b", "c")
+
+	for item := range s.All() {
+		fmt.Println(item)
+	}
+	for item := range s.Backward() {
+		fmt.Println(item)
+	}
+
+	// Output: a
+	// b
+	// c
+	// c
+	// b
+	// a
+}
+
+func ExampleImmutableSlice_subListToEnd() {
+	s := immutableslice.New("a", "b", "c")
+
+	// The end index is exclusive, so the size of the list can be passed to include the last element:
+	fmt.Println(s.SubList(1, s.Size()))
+
+	// Output: [b, c]
+}
@@ -42,10 +42,42 @@ type MutableIterator[T any] interface {
 	Iterator[T]
 	
 	// Remove removes the current element. Throws an ErrIndexOutOfBounds in a panic if the current iterator does not
-	// point to a valid element (e.g. before calling Next()).
+	// point to a valid element (e.g. before calling Next() or after calling Remove()).
 	Remove()
 }
 
+// ListIterator is an iterator over a list that can move in both directions. The iterator has a cursor that is always
+// between two elements: Next() returns the element after the cursor, Previous() the element before the cursor. The
+// mutating functions Remove(), Set() and Add() may not be supported by the underlying list, for example if it is an
+// ImmutableList, in which case an ErrUnsupportedOperation is thrown in a panic.
+type ListIterator[T any] interface {
+	MutableIterator[T]
+
+	// HasPrevious returns true if there is an element before the cursor. The cursor is not moved.
+	HasPrevious() bool
+
+	// Previous returns the element before the cursor and moves the cursor back by one. If there is no previous
+	// element, an ErrIndexOutOfBounds is thrown in a panic. Calling Next() and Previous() alternately returns the
+	// same element.
+	Previous() T
+
+	// NextIndex returns the index of the element that would be returned by Next(). At the end of the list this is the
+	// size of the list.
+	NextIndex() uint
+
+	// PreviousIndex returns the index of the element that would be returned by Previous(). At the start of the list
+	// this is -1.
+	PreviousIndex() int
+
+	// Set replaces the element last returned by Next() or Previous() with the passed element. If no element has been
+	// returned, or Remove() or Add() has been called since, an ErrIndexOutOfBounds is thrown in a panic.
+	Set(T)
+
+	// Add inserts the element before the cursor, so a subsequent Next() call is unaffected and a subsequent Previous()
+	// call returns the new element.
+	Add(T)
+}
+
 // IteratorCloser is an iterator that must be closed for an orderly shutdown.
 type IteratorCloser[T any] interface {
 	Iterator[T]
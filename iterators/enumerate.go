@@ -0,0 +1,31 @@
+package iterators
+
+import (
+	"github.com/apitalist/collections"
+)
+
+// Indexed is an element of an iterator together with its position, starting at 0.
+type Indexed[T any] struct {
+	Index uint
+	Value T
+}
+
+// Enumerate returns an iterator that pairs each element of the passed iterator with its position.
+func Enumerate[T any](it collections.Iterator[T]) collections.Iterator[Indexed[T]] {
+	next := pull(it)
+	index := uint(0)
+	return newIterator(
+		func() (Indexed[T], bool) {
+			e, ok := next()
+			if !ok {
+				return Indexed[T]{}, false
+			}
+			result := Indexed[T]{
+				Index: index,
+				Value: e,
+			}
+			index++
+			return result, true
+		},
+	)
+}
@@ -0,0 +1,21 @@
+package iterators_test
+
+import (
+	"fmt"
+
+	"github.com/apitalist/collections/iterators"
+	"github.com/apitalist/collections/slice"
+)
+
+func ExampleEnumerate() {
+	it := iterators.Enumerate(slice.New("a", "b", "c").Iterator())
+	it.ForEachRemaining(
+		func(e iterators.Indexed[string]) {
+			fmt.Printf("%d: %s\n", e.Index, e.Value)
+		},
+	)
+
+	// Output: 0: a
+	// 1: b
+	// 2: c
+}
@@ -0,0 +1,135 @@
+// Package iterators offers adapters that transform or combine collections.Iterator instances. Unlike streams, the
+// adapters do not spawn goroutines: elements are pulled lazily from the underlying iterators when HasNext(), Next()
+// or Peek() is called on the adapter. The adapters are not safe for concurrent use.
+package iterators
+
+import (
+	"github.com/apitalist/collections"
+)
+
+// newIterator creates an iterator that calls the fetch function to obtain the next element. The fetch function returns
+// false if there are no more elements, after which it is not called again.
+func newIterator[T any](fetch func() (T, bool)) *iterator[T] {
+	return &iterator[T]{
+		fetch: fetch,
+	}
+}
+
+// iterator is the base of all adapters in this package. It buffers one element fetched from upstream, which allows
+// HasNext() and Peek() to look ahead.
+type iterator[T any] struct {
+	fetch func() (T, bool)
+	// lookahead is the next element if it has already been fetched.
+	lookahead *T
+	// finished is true once fetch has returned false.
+	finished bool
+	// position is the number of elements returned from the iterator so far.
+	position uint
+}
+
+func (i *iterator[T]) ForEachRemaining(c collections.Consumer[T]) {
+	for i.HasNext() {
+		c(i.Next())
+	}
+}
+
+func (i *iterator[T]) HasNext() bool {
+	if i.lookahead == nil && !i.finished {
+		if e, ok := i.fetch(); ok {
+			i.lookahead = &e
+		} else {
+			i.finished = true
+		}
+	}
+	return i.lookahead != nil
+}
+
+func (i *iterator[T]) Next() T {
+	e := i.Peek()
+	i.lookahead = nil
+	i.position++
+	return e
+}
+
+func (i *iterator[T]) Peek() T {
+	if !i.HasNext() {
+		panic(collections.IndexOutOfBoundsError{Index: int(i.position), Size: i.position})
+	}
+	return *i.lookahead
+}
+
+// pull returns a fetch function that reads the next element from the passed iterator.
+func pull[T any](it collections.Iterator[T]) func() (T, bool) {
+	return func() (T, bool) {
+		if !it.HasNext() {
+			var defaultValue T
+			return defaultValue, false
+		}
+		return it.Next(), true
+	}
+}
+
+// Filter returns an iterator over the elements of the passed iterator that match the predicate.
+func Filter[T any](it collections.Iterator[T], predicate collections.Predicate[T]) collections.Iterator[T] {
+	return newIterator(
+		func() (T, bool) {
+			for it.HasNext() {
+				if e := it.Next(); predicate(e) {
+					return e, true
+				}
+			}
+			var defaultValue T
+			return defaultValue, false
+		},
+	)
+}
+
+// Map returns an iterator that applies the mapper function to each element of the passed iterator.
+func Map[TInput, TOutput any](
+	it collections.Iterator[TInput],
+	mapper func(TInput) TOutput,
+) collections.Iterator[TOutput] {
+	next := pull(it)
+	return newIterator(
+		func() (TOutput, bool) {
+			e, ok := next()
+			if !ok {
+				var defaultValue TOutput
+				return defaultValue, false
+			}
+			return mapper(e), true
+		},
+	)
+}
+
+// Chain returns an iterator over the elements of all passed iterators, one after another.
+func Chain[T any](its ...collections.Iterator[T]) collections.Iterator[T] {
+	return newIterator(
+		func() (T, bool) {
+			for len(its) > 0 {
+				if its[0].HasNext() {
+					return its[0].Next(), true
+				}
+				its = its[1:]
+			}
+			var defaultValue T
+			return defaultValue, false
+		},
+	)
+}
+
+// Limit returns an iterator over at most the first n elements of the passed iterator. The remaining elements are not
+// read from the passed iterator.
+func Limit[T any](it collections.Iterator[T], n uint) collections.Iterator[T] {
+	next := pull(it)
+	return newIterator(
+		func() (T, bool) {
+			if n == 0 {
+				var defaultValue T
+				return defaultValue, false
+			}
+			n--
+			return next()
+		},
+	)
+}
@@ -0,0 +1,102 @@
+package iterators_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/apitalist/collections/iterators"
+	"github.com/apitalist/collections/slice"
+)
+
+func Example() {
+	words := slice.New("apple", "Banana", "cherry", "Date", "elderberry")
+
+	// Adapters can be combined without creating any goroutines:
+	it := iterators.Limit(
+		iterators.Map(
+			iterators.Filter(
+				words.Iterator(),
+				func(word string) bool {
+					return strings.ToLower(word) != word
+				},
+			),
+			strings.ToUpper,
+		),
+		1,
+	)
+	for it.HasNext() {
+		fmt.Println(it.Next())
+	}
+
+	// Output: BANANA
+}
+
+func ExampleFilter() {
+	it := iterators.Filter(
+		slice.New(1, 2, 3, 4, 5, 6).Iterator(),
+		func(i int) bool {
+			return i%2 == 0
+		},
+	)
+	it.ForEachRemaining(
+		func(i int) {
+			fmt.Println(i)
+		},
+	)
+
+	// Output: 2
+	// 4
+	// 6
+}
+
+func ExampleMap() {
+	it := iterators.Map(
+		slice.New(1, 2, 3).Iterator(),
+		func(i int) string {
+			return strings.Repeat("*", i)
+		},
+	)
+	it.ForEachRemaining(
+		func(s string) {
+			fmt.Println(s)
+		},
+	)
+
+	// Output: *
+	// **
+	// ***
+}
+
+func ExampleChain() {
+	it := iterators.Chain(
+		slice.New("a", "b").Iterator(),
+		slice.New[string]().Iterator(),
+		slice.New("c").Iterator(),
+	)
+	it.ForEachRemaining(
+		func(s string) {
+			fmt.Println(s)
+		},
+	)
+
+	// Output: a
+	// b
+	// c
+}
+
+func ExampleLimit() {
+	source := slice.New(1, 2, 3, 4, 5).Iterator()
+	it := iterators.Limit(source, 2)
+	it.ForEachRemaining(
+		func(i int) {
+			fmt.Println(i)
+		},
+	)
+
+	// The remaining elements are still available from the source:
+	fmt.Println(source.Next())
+
+	// Output: 1
+	// 2
+	// 3
+}
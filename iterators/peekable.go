@@ -0,0 +1,24 @@
+package iterators
+
+import (
+	"github.com/apitalist/collections"
+)
+
+// PeekableIterator is an iterator that can return the next element without advancing, for example to implement
+// lookahead in a parser.
+type PeekableIterator[T any] interface {
+	collections.Iterator[T]
+
+	// Peek returns the next element without advancing the iterator, so the following Next() call returns the same
+	// element. If no more elements are remaining, an ErrIndexOutOfBounds is thrown in a panic.
+	Peek() T
+}
+
+// Peekable wraps the passed iterator into an iterator that supports Peek(). The passed iterator should not be used
+// directly afterwards, since Peek() may already have read an element from it.
+func Peekable[T any](it collections.Iterator[T]) PeekableIterator[T] {
+	if p, ok := it.(PeekableIterator[T]); ok {
+		return p
+	}
+	return newIterator(pull(it))
+}
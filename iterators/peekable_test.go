@@ -0,0 +1,32 @@
+package iterators_test
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/apitalist/collections/iterators"
+	"github.com/apitalist/collections/slice"
+)
+
+func ExamplePeekable() {
+	// A simple tokenizer that groups consecutive digits into numbers using lookahead:
+	input := slice.New([]rune("12+345-6")...)
+	it := iterators.Peekable(input.Iterator())
+
+	var tokens []string
+	for it.HasNext() {
+		if !unicode.IsDigit(it.Peek()) {
+			tokens = append(tokens, string(it.Next()))
+			continue
+		}
+		number := strings.Builder{}
+		for it.HasNext() && unicode.IsDigit(it.Peek()) {
+			number.WriteRune(it.Next())
+		}
+		tokens = append(tokens, number.String())
+	}
+	fmt.Println(strings.Join(tokens, " "))
+
+	// Output: 12 + 345 - 6
+}
@@ -0,0 +1,41 @@
+package iterators
+
+import (
+	"iter"
+
+	"github.com/apitalist/collections"
+)
+
+// FromSeq converts the passed iterator function into an iterator. The iterator function is only advanced when
+// HasNext(), Next() or Peek() requires the next element. If the returned iterator is not used until it runs out of
+// elements, Close() must be called to release the resources held by the iterator function.
+func FromSeq[T any](seq iter.Seq[T]) collections.IteratorCloser[T] {
+	next, stop := iter.Pull(seq)
+	return &seqIterator[T]{
+		iterator: newIterator(next),
+		stop:     stop,
+	}
+}
+
+type seqIterator[T any] struct {
+	*iterator[T]
+	stop func()
+}
+
+func (s *seqIterator[T]) Close() error {
+	s.stop()
+	return nil
+}
+
+// ToSeq converts the passed iterator into an iterator function for use in a for-range loop. The iterator is advanced
+// as the loop progresses, so the remaining elements are still available from the iterator if the loop is stopped
+// early.
+func ToSeq[T any](it collections.Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it.HasNext() {
+			if !yield(it.Next()) {
+				return
+			}
+		}
+	}
+}
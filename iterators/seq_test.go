@@ -0,0 +1,36 @@
+package iterators_test
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/apitalist/collections/iterators"
+	"github.com/apitalist/collections/slice"
+)
+
+func ExampleFromSeq() {
+	// Standard library iterator functions can be used with the adapters:
+	it := iterators.FromSeq(slices.Values([]string{"a", "b", "c"}))
+	defer func() {
+		_ = it.Close()
+	}()
+
+	enumerated := iterators.Enumerate(it)
+	for enumerated.HasNext() {
+		e := enumerated.Next()
+		fmt.Printf("%d: %s\n", e.Index, e.Value)
+	}
+
+	// Output: 0: a
+	// 1: b
+	// 2: c
+}
+
+func ExampleToSeq() {
+	it := slice.New(3, 1, 2).Iterator()
+
+	// Iterators can be passed to standard library functions accepting iterator functions:
+	fmt.Println(slices.Sorted(iterators.ToSeq(it)))
+
+	// Output: [1 2 3]
+}
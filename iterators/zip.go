@@ -0,0 +1,30 @@
+package iterators
+
+import (
+	"github.com/apitalist/collections"
+)
+
+// Pair holds two elements taken at the same position from two iterators.
+type Pair[TFirst, TSecond any] struct {
+	First  TFirst
+	Second TSecond
+}
+
+// Zip returns an iterator that pairs the elements of the two passed iterators by position. The returned iterator ends
+// when either of the passed iterators runs out of elements.
+func Zip[TFirst, TSecond any](
+	first collections.Iterator[TFirst],
+	second collections.Iterator[TSecond],
+) collections.Iterator[Pair[TFirst, TSecond]] {
+	return newIterator(
+		func() (Pair[TFirst, TSecond], bool) {
+			if !first.HasNext() || !second.HasNext() {
+				return Pair[TFirst, TSecond]{}, false
+			}
+			return Pair[TFirst, TSecond]{
+				First:  first.Next(),
+				Second: second.Next(),
+			}, true
+		},
+	)
+}
@@ -0,0 +1,23 @@
+package iterators_test
+
+import (
+	"fmt"
+
+	"github.com/apitalist/collections/iterators"
+	"github.com/apitalist/collections/slice"
+)
+
+func ExampleZip() {
+	it := iterators.Zip(
+		slice.New("a", "b", "c").Iterator(),
+		slice.New(1, 2).Iterator(),
+	)
+	it.ForEachRemaining(
+		func(p iterators.Pair[string, int]) {
+			fmt.Printf("%s%d\n", p.First, p.Second)
+		},
+	)
+
+	// Output: a1
+	// b2
+}
@@ -1,5 +1,7 @@
 package collections
 
+import "iter"
+
 // List is an structure where elements are stored in-order and elements may repeat. This interface offers functions
 // to access a specific item of that list. The underlying implementation, for example slice from the slice package,
 // determines the execution speed of these operations.
@@ -23,6 +25,26 @@ type List[E comparable, T any] interface {
 	// If no item is found, an ErrElementNotFound is thrown in a panic.
 	LastIndexOf(E) uint
 
+	// TryGet returns the item located at the specified index, starting at 0 for the first item, and true. If the index
+	// is not in the list, the zero value and false is returned instead of panicking.
+	TryGet(index uint) (E, bool)
+
+	// Find returns the index of the first item in the list matching the specified element and true. If no item is
+	// found, 0 and false is returned instead of panicking.
+	Find(E) (uint, bool)
+
+	// FindLast returns the index of the last item in the list matching the specified element and true. If no item is
+	// found, 0 and false is returned instead of panicking.
+	FindLast(E) (uint, bool)
+
+	// Backward returns an iterator function over the elements of the list in reverse order, starting with the last
+	// element, for use in a for-range loop.
+	Backward() iter.Seq[E]
+
+	// ListIterator returns an iterator that can move in both directions, starting with the cursor before the element
+	// at the specified index. If the index is larger than the list size, an ErrIndexOutOfBounds is thrown in a panic.
+	ListIterator(start uint) ListIterator[E]
+
 	// SubList creates a list from a part from the current list, starting at the from parameter (inclusive) up until
 	// the to parameter (exclusive). If the specified bounds are invalid (from is larger than to, or to is larger than
 	// the list length), an ErrIndexOutOfBounds is returned thrown in a panic.
@@ -45,8 +67,8 @@ type MutableList[E comparable] interface {
 	Set(index uint, element E) MutableList[E]
 
 	// Sort sorts the current list with the help of the passed comparator function. The comparator function will be
-	// called several times, each time with two values. If the comparator returns a value smaller than zero, the two
-	// items will be swapped in the list.
+	// called several times, each time with two values. If the comparator returns a value smaller than zero, the first
+	// value is placed before the second value in the list. Elements the comparator considers equal keep their order.
 	Sort(Comparator[E]) MutableList[E]
 
 	// RemoveAt removes the element at the specified index. If the specified index does not exist a
@@ -72,8 +94,8 @@ type ImmutableList[E comparable] interface {
 
 	// WithSorted returns a new list with the elements from the current list sorted according to the passed comparator
 	// function. The current list remains unchanged in the process. The comparator function will be called several
-	// times, each time with two values. If the comparator returns a value smaller than zero, the two items will be
-	// swapped in the list.
+	// times, each time with two values. If the comparator returns a value smaller than zero, the first value is placed
+	// before the second value in the list. Elements the comparator considers equal keep their order.
 	WithSorted(Comparator[E]) ImmutableList[E]
 
 	// WithRemovedAt returns a new list with the item at the specified index removed. The current list remains unchanged
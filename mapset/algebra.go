@@ -0,0 +1,100 @@
+package mapset
+
+import (
+    "github.com/apitalist/collections"
+)
+
+// Union returns a new set with the elements contained in either of the passed sets.
+func Union[V comparable](a, b collections.Set[V]) MapSet[V] {
+    result := withCapacity[V](a.Size() + b.Size())
+    for e := range a.All() {
+        result.data[e] = struct{}{}
+    }
+    for e := range b.All() {
+        result.data[e] = struct{}{}
+    }
+    return result
+}
+
+// Intersection returns a new set with the elements contained in both of the passed sets.
+func Intersection[V comparable](a, b collections.Set[V]) MapSet[V] {
+    smaller, larger := sortBySize(a, b)
+    result := withCapacity[V](smaller.Size())
+    for e := range smaller.All() {
+        if larger.Contains(e) {
+            result.data[e] = struct{}{}
+        }
+    }
+    return result
+}
+
+// Difference returns a new set with the elements of the first set that are not contained in the second set.
+func Difference[V comparable](a, b collections.Set[V]) MapSet[V] {
+    result := withCapacity[V](a.Size())
+    for e := range a.All() {
+        if !b.Contains(e) {
+            result.data[e] = struct{}{}
+        }
+    }
+    return result
+}
+
+// SymmetricDifference returns a new set with the elements contained in exactly one of the passed sets.
+func SymmetricDifference[V comparable](a, b collections.Set[V]) MapSet[V] {
+    result := withCapacity[V](a.Size() + b.Size())
+    for e := range a.All() {
+        if !b.Contains(e) {
+            result.data[e] = struct{}{}
+        }
+    }
+    for e := range b.All() {
+        if !a.Contains(e) {
+            result.data[e] = struct{}{}
+        }
+    }
+    return result
+}
+
+// IsSubsetOf returns true if all elements of the first set are contained in the second set.
+func IsSubsetOf[V comparable](a, b collections.Set[V]) bool {
+    if a.Size() > b.Size() {
+        return false
+    }
+    for e := range a.All() {
+        if !b.Contains(e) {
+            return false
+        }
+    }
+    return true
+}
+
+// IsSupersetOf returns true if the first set contains all elements of the second set.
+func IsSupersetOf[V comparable](a, b collections.Set[V]) bool {
+    return IsSubsetOf(b, a)
+}
+
+// IsDisjoint returns true if the passed sets have no elements in common.
+func IsDisjoint[V comparable](a, b collections.Set[V]) bool {
+    smaller, larger := sortBySize(a, b)
+    for e := range smaller.All() {
+        if larger.Contains(e) {
+            return false
+        }
+    }
+    return true
+}
+
+// sortBySize returns the passed sets with the smaller set first, so operations can iterate over the smaller set and
+// look up its elements in the larger one.
+func sortBySize[V comparable](a, b collections.Set[V]) (collections.Set[V], collections.Set[V]) {
+    if a.Size() > b.Size() {
+        return b, a
+    }
+    return a, b
+}
+
+func withCapacity[V comparable](capacity uint) *mapSet[V] {
+    return &mapSet[V]{
+        data: make(map[V]struct{}, capacity),
+    }
+}
@@ -0,0 +1,67 @@
+package mapset_test
+
+import (
+    "fmt"
+    "slices"
+
+    "github.com/apitalist/collections/mapset"
+)
+
+func ExampleUnion() {
+    a := mapset.New(1, 2, 3)
+    b := mapset.New(3, 4)
+
+    fmt.Println(slices.Sorted(mapset.Union(a, b).All()))
+
+    // Output: [1 2 3 4]
+}
+
+func ExampleIntersection() {
+    a := mapset.New(1, 2, 3)
+    b := mapset.New(2, 3, 4)
+
+    fmt.Println(slices.Sorted(mapset.Intersection(a, b).All()))
+
+    // Output: [2 3]
+}
+
+func ExampleDifference() {
+    a := mapset.New(1, 2, 3)
+    b := mapset.New(2, 3, 4)
+
+    fmt.Println(slices.Sorted(mapset.Difference(a, b).All()))
+
+    // Output: [1]
+}
+
+func ExampleSymmetricDifference() {
+    a := mapset.New(1, 2, 3)
+    b := mapset.New(2, 3, 4)
+
+    fmt.Println(slices.Sorted(mapset.SymmetricDifference(a, b).All()))
+
+    // Output: [1 4]
+}
+
+func ExampleIsSubsetOf() {
+    a := mapset.New(1, 2)
+    b := mapset.New(1, 2, 3)
+
+    fmt.Println(mapset.IsSubsetOf(a, b))
+    fmt.Println(mapset.IsSupersetOf(a, b))
+
+    // Output: true
+    // false
+}
+
+func ExampleIsDisjoint() {
+    a := mapset.New(1, 2)
+    b := mapset.New(3, 4)
+    c := mapset.New(2, 5)
+
+    fmt.Println(mapset.IsDisjoint(a, b))
+    fmt.Println(mapset.IsDisjoint(a, c))
+
+    // Output: true
+    // false
+}
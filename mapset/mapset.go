@@ -4,6 +4,7 @@ package mapset
 
 import (
     "fmt"
+    "iter"
 
     "github.com/apitalist/collections"
     "github.com/apitalist/collections/stream"
@@ -11,105 +12,140 @@ import (
 
 // New creates a new MapSet, a set implementation that stores data in a map.
 func New[V comparable](elements ...V) MapSet[V] {
-    s := make(mapSet[V], len(elements))
+    s := &mapSet[V]{
+        data: make(map[V]struct{}, len(elements)),
+    }
     for _, e := range elements {
-        s[e] = struct{}{}
+        s.data[e] = struct{}{}
     }
-    return &s
+    return s
 }
 
-// MapSet is an interface describing a map-based set implementation.
+// MapSet is an interface describing a map-based set implementation. Iterators of the set panic with a
+// collections.ErrConcurrentModification if the set is modified while iterating, other than through the Remove()
+// function of the iterator itself.
 type MapSet[V comparable] interface {
     collections.MutableSet[V]
 }
 
-type mapSet[V comparable] map[V]struct{}
+type mapSet[V comparable] struct {
+    data map[V]struct{}
+    // modCount is incremented on every change of the set, so iterators can detect concurrent modification.
+    modCount uint
+}
 
 func (m *mapSet[V]) MutableIterator() collections.MutableIterator[V] {
     return &iterator[V]{
-        set:  m,
-        data: m.ToSlice(),
-        i:    -1,
+        set:      m,
+        mutable:  true,
+        data:     m.ToSlice(),
+        i:        -1,
+        modCount: m.modCount,
     }
 }
 
-func (m mapSet[V]) Add(e V) {
-    m[e] = struct{}{}
+func (m *mapSet[V]) Add(e V) {
+    if _, ok := m.data[e]; ok {
+        return
+    }
+    m.data[e] = struct{}{}
+    m.modCount++
 }
 
-func (m mapSet[V]) AddAll(c collections.Collection[V]) {
+func (m *mapSet[V]) AddAll(c collections.Collection[V]) {
     c.Iterator().ForEachRemaining(func(e V) {
         m.Add(e)
     })
 }
 
 func (m *mapSet[V]) Clear() {
-    newM := make(map[V]struct{}, 0)
-    *m = newM
+    m.data = make(map[V]struct{}, 0)
+    m.modCount++
 }
 
-func (m mapSet[V]) Remove(e V) {
-    _, ok := m[e]
+func (m *mapSet[V]) Remove(e V) {
+    _, ok := m.data[e]
     if !ok {
-        panic(collections.ErrElementNotFound)
+        panic(collections.ElementNotFoundError{Element: e})
     }
-    delete(m, e)
+    m.delete(e)
 }
 
-func (m mapSet[V]) RemoveAll(c collections.Collection[V]) {
+func (m *mapSet[V]) RemoveAll(c collections.Collection[V]) {
     i := c.Iterator()
     for i.HasNext() {
         e := i.Next()
-        delete(m, e)
+        if _, ok := m.data[e]; ok {
+            m.delete(e)
+        }
     }
 }
 
-func (m mapSet[V]) RemoveIf(p collections.Predicate[V]) {
-    for e := range m {
+func (m *mapSet[V]) RemoveIf(p collections.Predicate[V]) {
+    for e := range m.data {
         if p(e) {
-            delete(m, e)
+            m.delete(e)
         }
     }
 }
 
-func (m mapSet[V]) RetainAll(c collections.Collection[V]) {
+func (m *mapSet[V]) RetainAll(c collections.Collection[V]) {
     m.RemoveIf(collections.Predicate[V](c.Contains).Negate())
 }
 
-func (m mapSet[V]) Iterator() collections.Iterator[V] {
+func (m *mapSet[V]) delete(e V) {
+    delete(m.data, e)
+    m.modCount++
+}
+
+func (m *mapSet[V]) Iterator() collections.Iterator[V] {
     return &iterator[V]{
-        data: m.ToSlice(),
-        i:    -1,
+        set:      m,
+        data:     m.ToSlice(),
+        i:        -1,
+        modCount: m.modCount,
     }
 }
 
-func (m mapSet[V]) Contains(e V) bool {
-    _, ok := m[e]
+// All iterates over the elements of the set in no particular order. The set must not be modified during iteration,
+// other than removing the element currently being iterated over.
+func (m *mapSet[V]) All() iter.Seq[V] {
+    return func(yield func(V) bool) {
+        for e := range m.data {
+            if !yield(e) {
+                return
+            }
+        }
+    }
+}
+
+func (m *mapSet[V]) Contains(e V) bool {
+    _, ok := m.data[e]
     return ok
 }
 
-func (m mapSet[V]) IsEmpty() bool {
-    return len(m) == 0
+func (m *mapSet[V]) IsEmpty() bool {
+    return len(m.data) == 0
 }
 
-func (m mapSet[V]) Size() uint {
-    return uint(len(m))
+func (m *mapSet[V]) Size() uint {
+    return uint(len(m.data))
 }
 
-func (m mapSet[V]) ToSlice() []V {
-    result := make([]V, len(m))
+func (m *mapSet[V]) ToSlice() []V {
+    result := make([]V, len(m.data))
     i := 0
-    for e := range m {
+    for e := range m.data {
         result[i] = e
         i++
     }
     return result
 }
 
-func (m mapSet[V]) String() string {
+func (m *mapSet[V]) String() string {
     i := 0
     result := "["
-    for e := range m {
+    for e := range m.data {
         if i > 0 {
             result += ", "
         }
@@ -120,24 +156,41 @@ func (m mapSet[V]) String() string {
     return result
 }
 
-func (m mapSet[V]) Stream() collections.Stream[V] {
+func (m *mapSet[V]) Stream() collections.Stream[V] {
     return stream.FromCollection[V](m)
 }
 
 type iterator[V comparable] struct {
-    set  *mapSet[V]
-    data []V
-    i    int
+    set     *mapSet[V]
+    mutable bool
+    data    []V
+    i       int
+    // removed is true if the current element has been removed.
+    removed bool
+    // modCount is the modification count of the set the iterator expects. If the set has a different count, it has
+    // been modified outside the iterator.
+    modCount uint
+}
+
+// checkModification panics with a collections.ErrConcurrentModification if the set has been modified outside the
+// iterator.
+func (i *iterator[V]) checkModification() {
+    if i.set.modCount != i.modCount {
+        panic(collections.ErrConcurrentModification)
+    }
 }
 
 func (i *iterator[V]) Remove() {
-    if i.set == nil {
+    if !i.mutable {
         panic(fmt.Errorf("iterator is not mutable"))
     }
-    if i.i >= len(i.data) {
+    i.checkModification()
+    if i.i < 0 || i.removed {
         panic(collections.ErrIndexOutOfBounds)
     }
-    delete(*i.set, i.data[i.i])
+    i.set.delete(i.data[i.i])
+    i.removed = true
+    i.modCount = i.set.modCount
 }
 
 func (i *iterator[V]) ForEachRemaining(c collections.Consumer[V]) {
@@ -151,9 +204,11 @@ func (i *iterator[V]) HasNext() bool {
 }
 
 func (i *iterator[V]) Next() V {
+    i.checkModification()
     if i.i >= len(i.data)-1 {
-        panic(collections.ErrIndexOutOfBounds)
+        panic(collections.IndexOutOfBoundsError{Index: i.i + 1, Size: uint(len(i.data))})
     }
     i.i++
+    i.removed = false
     return i.data[i.i]
 }
@@ -1,12 +1,14 @@
 package mapset_test
 
 import (
+    "errors"
     "fmt"
     "sort"
     "strings"
 
     "github.com/apitalist/collections"
     "github.com/apitalist/collections/mapset"
+    "github.com/apitalist/lang"
     "github.com/apitalist/lang/try"
     "github.com/apitalist/lang/try/catch"
 )
@@ -352,3 +354,52 @@ func ExampleIterator_next() {
     // Output: [a b c]
     // set finished!
 }
+
+func ExampleMapSet_concurrentModification() {
+    set := mapset.New("a", "b", "c")
+
+    iterator := set.Iterator()
+    _ = iterator.Next()
+
+    // Modifying the set outside the iterator invalidates the iterator.
+    set.Add("d")
+
+    try.Catch(
+        func() {
+            _ = iterator.Next()
+        },
+        catch.ErrorByValue(
+            collections.ErrConcurrentModification, func(_ error) {
+                fmt.Println("The set has been modified!")
+            },
+        ),
+    )
+
+    // Output: The set has been modified!
+}
+
+func ExampleMapSet_iteratorRemoveBeforeNext() {
+    set := mapset.New("a", "b", "c")
+
+    // Removing before an element has been returned results in an error:
+    err := lang.Safe(
+        func() {
+            set.MutableIterator().Remove()
+        },
+    )
+    fmt.Println(errors.Is(err, collections.ErrIndexOutOfBounds))
+
+    // Output: true
+}
+
+func ExampleMapSet_all() {
+    set := mapset.New(1, 2, 3)
+
+    sum := 0
+    for e := range set.All() {
+        sum += e
+    }
+    fmt.Println(sum)
+
+    // Output: 6
+}
@@ -30,3 +30,78 @@ func (p Predicate[E]) Or(p2 Predicate[E]) Predicate[E] {
 		return p(e) || p2(e)
 	}
 }
+
+// Xor creates a new predicate with the current predicate and the passed predicate combined in an exclusive OR boolean
+// relation. The new predicate returns true if exactly one of the predicates returns true.
+func (p Predicate[E]) Xor(p2 Predicate[E]) Predicate[E] {
+	return func(e E) bool {
+		return p(e) != p2(e)
+	}
+}
+
+// Not creates a new predicate with the negated effect of the passed predicate. It is equivalent to calling Negate(), but
+// reads better when composing predicates from plain functions.
+func Not[E any](p Predicate[E]) Predicate[E] {
+	return p.Negate()
+}
+
+// AllOf creates a new predicate that returns true if all passed predicates return true. The predicates are evaluated
+// in order until one of them returns false. If no predicates are passed, the new predicate always returns true.
+func AllOf[E any](predicates ...Predicate[E]) Predicate[E] {
+	return func(e E) bool {
+		for _, p := range predicates {
+			if !p(e) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// AnyOf creates a new predicate that returns true if any of the passed predicates returns true. The predicates are
+// evaluated in order until one of them returns true. If no predicates are passed, the new predicate always returns
+// false.
+func AnyOf[E any](predicates ...Predicate[E]) Predicate[E] {
+	return func(e E) bool {
+		for _, p := range predicates {
+			if p(e) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NoneOf creates a new predicate that returns true if none of the passed predicates returns true. If no predicates are
+// passed, the new predicate always returns true.
+func NoneOf[E any](predicates ...Predicate[E]) Predicate[E] {
+	return AnyOf(predicates...).Negate()
+}
+
+// IsEqual creates a predicate that returns true if the element is equal to the passed value.
+func IsEqual[E comparable](value E) Predicate[E] {
+	return func(e E) bool {
+		return e == value
+	}
+}
+
+// In creates a predicate that returns true if the passed collection contains the element. The collection is queried
+// each time the predicate is called, so changes to a mutable collection are reflected in the predicate.
+func In[E comparable](c Collection[E]) Predicate[E] {
+	return c.Contains
+}
+
+// By creates a predicate that extracts a key from the element and passes it to the passed predicate. For example, to
+// match people by their name:
+//
+//     collections.By(
+//         func(p person) string {
+//             return p.name
+//         },
+//         collections.IsEqual("Alice"),
+//     )
+func By[E any, K any](key func(E) K, p Predicate[K]) Predicate[E] {
+	return func(e E) bool {
+		return p(key(e))
+	}
+}
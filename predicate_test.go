@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/apitalist/collections"
+	"github.com/apitalist/collections/slice"
 )
 
 func ExamplePredicate() {
@@ -77,3 +78,75 @@ func ExamplePredicate_Negate() {
 
 	// Output: 5 is larger than 4
 }
+
+func ExamplePredicate_Xor() {
+	isEven := func(e int) bool {
+		return e%2 == 0
+	}
+	isLargerThanThree := func(e int) bool {
+		return e > 3
+	}
+	p := collections.NewPredicate(isEven).Xor(isLargerThanThree)
+	for _, e := range []int{2, 4, 5} {
+		fmt.Printf("%d: %t\n", e, p(e))
+	}
+
+	// Output: 2: true
+	// 4: false
+	// 5: true
+}
+
+func ExampleAllOf() {
+	list := slice.New(1, 2, 3, 4, 5, 6, 7, 8)
+
+	list.RemoveIf(
+		collections.AllOf(
+			func(e int) bool {
+				return e%2 == 0
+			},
+			collections.Not(collections.In[int](slice.New(4, 8))),
+		),
+	)
+	fmt.Println(list)
+
+	// Output: [1, 3, 4, 5, 7, 8]
+}
+
+func ExampleAnyOf() {
+	list := slice.New("a", "b", "c", "d")
+
+	list.RemoveIf(collections.AnyOf(collections.IsEqual("b"), collections.IsEqual("d")))
+	fmt.Println(list)
+
+	// Output: [a, c]
+}
+
+func ExampleNoneOf() {
+	p := collections.NoneOf(collections.IsEqual(1), collections.IsEqual(2))
+
+	fmt.Println(p(1), p(3))
+
+	// Output: false true
+}
+
+func ExampleBy() {
+	people := slice.New(
+		person{"Alice", 45},
+		person{"Bob", 17},
+		person{"Carol", 32},
+	)
+
+	adults := people.Stream().Filter(
+		collections.By(
+			func(p person) int {
+				return p.age
+			},
+			func(age int) bool {
+				return age >= 18
+			},
+		),
+	).ToSlice()
+	fmt.Println(adults)
+
+	// Output: [{Alice 45} {Carol 32}]
+}
@@ -1,10 +1,14 @@
 // Package slice offers a go slice-backed list implementation that is mutable (can be changed in-place). The List API
 // gives a rich set of features and supports code completion at minimal overhead. However, the Slice implementation is
 // not concurrency-safe, parallel modifications of the Slice should be avoided by using locks.
+//
+// The Tracked list wraps a Slice and counts its modifications, so its iterators fail fast when the list is modified
+// while iterating.
 package slice
 
 import (
 	"fmt"
+	"iter"
 	"sort"
 	"strings"
 
@@ -68,28 +72,57 @@ func (s *Slice[E]) Stream() collections.Stream[E] {
 // collections.ErrIndexOutOfBounds is thrown in a panic.
 func (s *Slice[E]) RemoveAt(index uint) collections.MutableList[E] {
 	if index >= uint(len(*s)) {
-		panic(collections.ErrIndexOutOfBounds)
+		panic(collections.IndexOutOfBoundsError{Index: int(index), Size: uint(len(*s))})
 	}
 	*s = append((*s)[:index], (*s)[index+1:]...)
 	return s
 }
 
 // Iterator returns an iterator to loop over the elements. Multiple concurrent iterators for the Slice may exist, but
-// concurrent modification must be locked externally.
+// concurrent modification must be locked externally. Modifications of the Slice while iterating are not detected, use
+// a Tracked list if the iterator should fail on them.
 func (s *Slice[E]) Iterator() collections.Iterator[E] {
-	return &Iterator[E]{
-		s,
-		-1,
-	}
+	return newIterator(s, nil, 0)
 }
 
 // MutableIterator returns a mutable iterator to loop over the elements. It also offers the ability to remove the
 // current element from the list. Multiple concurrent iterators for the Slice may exist, but concurrent modification
-// must be locked externally.
+// must be locked externally. Modifications of the Slice other than through the Remove() function of the iterator are
+// not detected, use a Tracked list if the iterator should fail on them.
 func (s *Slice[E]) MutableIterator() collections.MutableIterator[E] {
-	return &Iterator[E]{
-		s,
-		-1,
+	return newIterator(s, nil, 0)
+}
+
+// ListIterator returns an iterator that can move in both directions and modify the Slice, starting before the element
+// at the specified index. If the index is larger than the Slice size, a collections.ErrIndexOutOfBounds is thrown in a
+// panic. Modifications of the Slice other than through the iterator are not detected, use a Tracked list if the
+// iterator should fail on them.
+func (s *Slice[E]) ListIterator(start uint) collections.ListIterator[E] {
+	if start > uint(len(*s)) {
+		panic(collections.IndexOutOfBoundsError{Index: int(start), Size: uint(len(*s))})
+	}
+	return newIterator(s, nil, int(start))
+}
+
+// All returns an iterator function over the elements of the Slice for use in a for-range loop.
+func (s Slice[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, e := range s {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator function over the elements of the Slice in reverse order for use in a for-range loop.
+func (s Slice[E]) Backward() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for i := len(s) - 1; i >= 0; i-- {
+			if !yield(s[i]) {
+				return
+			}
+		}
 	}
 }
 
@@ -121,43 +154,75 @@ func (s Slice[E]) Contains(e E) bool {
 // Get will return the element at the specified index. If the index is larger than the number of elements, a
 // collections.ErrIndexOutOfBounds is thrown in a panic.
 func (s Slice[E]) Get(index uint) E {
+	e, ok := s.TryGet(index)
+	if !ok {
+		panic(collections.IndexOutOfBoundsError{Index: int(index), Size: uint(len(s))})
+	}
+	return e
+}
+
+// TryGet returns the element at the specified index and true. If the index is larger than the number of elements, the
+// zero value and false is returned.
+func (s Slice[E]) TryGet(index uint) (E, bool) {
 	if index >= uint(len(s)) {
-		panic(collections.ErrIndexOutOfBounds)
+		var defaultValue E
+		return defaultValue, false
 	}
-	return s[index]
+	return s[index], true
 }
 
 // IndexOf returns the index of the first element that matches the specified element. If no element is found,
 // a collections.ErrElementNotFound is thrown in a panic.
 func (s Slice[E]) IndexOf(e E) uint {
+	index, ok := s.Find(e)
+	if !ok {
+		panic(collections.ElementNotFoundError{Element: e})
+	}
+	return index
+}
+
+// Find returns the index of the first element that matches the specified element and true. If no element is found, 0
+// and false is returned.
+func (s Slice[E]) Find(e E) (uint, bool) {
 	for i, elem := range s {
 		if elem == e {
-			return uint(i)
+			return uint(i), true
 		}
 	}
-	panic(collections.ErrElementNotFound)
+	return 0, false
 }
 
 // LastIndexOf returns the index of the last element that matches the specified element. If no element is found,
 // a collections.ErrElementNotFound is thrown in a panic.
 func (s Slice[E]) LastIndexOf(e E) uint {
+	index, ok := s.FindLast(e)
+	if !ok {
+		panic(collections.ElementNotFoundError{Element: e})
+	}
+	return index
+}
+
+// FindLast returns the index of the last element that matches the specified element and true. If no element is found,
+// 0 and false is returned.
+func (s Slice[E]) FindLast(e E) (uint, bool) {
 	for i := len(s) - 1; i >= 0; i-- {
 		elem := s[i]
 		if elem == e {
-			return uint(i)
+			return uint(i), true
 		}
 	}
-	panic(collections.ErrElementNotFound)
+	return 0, false
 }
 
-// SubList will return a part of the current Slice. If the specified bounds are invalid, a
+// SubList will return a part of the current Slice from the from index (inclusive) up until the to index (exclusive).
+// The to index may be the size of the Slice to include the last element. If the specified bounds are invalid, a
 // collections.ErrIndexOutOfBounds is thrown in a panic.
 func (s Slice[E]) SubList(from, to uint) collections.MutableList[E] {
 	if from > to {
-		panic(collections.ErrIndexOutOfBounds)
+		panic(collections.IndexOutOfBoundsError{Index: int(from), Size: uint(len(s))})
 	}
-	if to >= uint(len(s)) {
-		panic(collections.ErrIndexOutOfBounds)
+	if to > uint(len(s)) {
+		panic(collections.IndexOutOfBoundsError{Index: int(to), Size: uint(len(s))})
 	}
 	subSlice := s[from:to]
 	newSlice := make([]E, len(subSlice))
@@ -215,7 +280,7 @@ func (s *Slice[E]) RetainAll(c collections.Collection[E]) {
 
 func (s *Slice[E]) AddAt(index uint, element E) collections.MutableList[E] {
 	if index > uint(len(*s)) {
-		panic(collections.ErrIndexOutOfBounds)
+		panic(collections.IndexOutOfBoundsError{Index: int(index), Size: uint(len(*s))})
 	}
 	if index == uint(len(*s)) {
 		*s = append(*s, element)
@@ -230,7 +295,7 @@ func (s *Slice[E]) AddAt(index uint, element E) collections.MutableList[E] {
 // is thrown in a panic.
 func (s *Slice[E]) Set(index uint, element E) collections.MutableList[E] {
 	if index >= uint(len(*s)) {
-		panic(collections.ErrIndexOutOfBounds)
+		panic(collections.IndexOutOfBoundsError{Index: int(index), Size: uint(len(*s))})
 	}
 	(*s)[index] = element
 	return s
@@ -255,11 +320,56 @@ func (s Slice[E]) String() string {
 	return "[" + strings.Join(result, ", ") + "]"
 }
 
-// Iterator is an interator looping over a Slice. You can create it by calling Iterator() or MutableIterator() on a
-// Slice.
+// Iterator is an interator looping over a Slice. You can create it by calling Iterator(), MutableIterator() or
+// ListIterator() on a Slice or a Tracked list.
+//
+// Since the Slice is a plain go slice, it cannot keep track of modifications, so iterators of a Slice do not detect
+// modifications made outside the iterator. Iterators of a Tracked list panic with a
+// collections.ErrConcurrentModification if the list is modified other than through the iterator.
 type Iterator[E comparable] struct {
 	backingSlice *Slice[E]
-	index        int
+	// modCount points to the modification count of the Tracked list the iterator belongs to. It is nil for iterators of
+	// a Slice.
+	modCount *uint
+	// expectedModCount is the modification count of the list the iterator expects. If the list has a different count,
+	// it has been modified outside the iterator.
+	expectedModCount uint
+	// cursor is the index of the element returned by the next call to Next().
+	cursor int
+	// lastReturned is the index of the element last returned by Next() or Previous(), or -1 if there is none or it
+	// has been removed.
+	lastReturned int
+}
+
+// newIterator creates an iterator over the Slice starting before the element at the specified index. The modCount
+// may be nil if modifications should not be detected.
+func newIterator[E comparable](s *Slice[E], modCount *uint, start int) *Iterator[E] {
+	i := &Iterator[E]{
+		backingSlice: s,
+		modCount:     modCount,
+		cursor:       start,
+		lastReturned: -1,
+	}
+	if modCount != nil {
+		i.expectedModCount = *modCount
+	}
+	return i
+}
+
+// checkModification panics with a collections.ErrConcurrentModification if the list has been modified outside the
+// iterator.
+func (s *Iterator[E]) checkModification() {
+	if s.modCount != nil && *s.modCount != s.expectedModCount {
+		panic(collections.ErrConcurrentModification)
+	}
+}
+
+// modified records a modification made through the iterator, so other iterators of the list detect it.
+func (s *Iterator[E]) modified() {
+	if s.modCount != nil {
+		*s.modCount++
+		s.expectedModCount = *s.modCount
+	}
 }
 
 // ForEachRemaining executes the specified consumer function on each remaining elements until no more elements remain
@@ -272,7 +382,7 @@ func (s *Iterator[E]) ForEachRemaining(f collections.Consumer[E]) {
 
 // HasNext returns true if the iterator has more elements remaining.
 func (s Iterator[E]) HasNext() bool {
-	return s.index < len(*s.backingSlice)-1
+	return s.cursor < len(*s.backingSlice)
 }
 
 // Next retrieves the next element. If no more elements are remaining, an ErrIndexOutOfBounds error is returned. This
@@ -286,17 +396,74 @@ func (s Iterator[E]) HasNext() bool {
 //         }
 //     }
 func (s *Iterator[E]) Next() E {
-	if s.index >= len(*s.backingSlice)-1 {
-		panic(collections.ErrIndexOutOfBounds)
+	s.checkModification()
+	if s.cursor >= len(*s.backingSlice) {
+		panic(collections.IndexOutOfBoundsError{Index: s.cursor, Size: uint(len(*s.backingSlice))})
+	}
+	s.lastReturned = s.cursor
+	s.cursor++
+	return (*s.backingSlice)[s.lastReturned]
+}
+
+// HasPrevious returns true if there is an element before the cursor of the iterator.
+func (s Iterator[E]) HasPrevious() bool {
+	return s.cursor > 0
+}
+
+// Previous retrieves the element before the cursor and moves the cursor back. If the iterator is at the start of the
+// Slice, an ErrIndexOutOfBounds error is thrown in a panic.
+func (s *Iterator[E]) Previous() E {
+	s.checkModification()
+	if s.cursor <= 0 {
+		panic(collections.IndexOutOfBoundsError{Index: s.cursor - 1, Size: uint(len(*s.backingSlice))})
 	}
-	s.index++
-	return (*s.backingSlice)[s.index]
+	s.cursor--
+	s.lastReturned = s.cursor
+	return (*s.backingSlice)[s.lastReturned]
+}
+
+// NextIndex returns the index of the element that would be returned by Next().
+func (s Iterator[E]) NextIndex() uint {
+	return uint(s.cursor)
+}
+
+// PreviousIndex returns the index of the element that would be returned by Previous(), or -1 at the start of the
+// Slice.
+func (s Iterator[E]) PreviousIndex() int {
+	return s.cursor - 1
 }
 
-// Remove removes the current element from the underlying Slice.
+// Remove removes the element last returned by Next() or Previous() from the underlying Slice. If no element has been
+// returned yet, or the element has already been removed, an ErrIndexOutOfBounds is thrown in a panic.
 func (s *Iterator[E]) Remove() {
-	if s.index >= len(*s.backingSlice) {
+	s.checkModification()
+	if s.lastReturned < 0 {
 		panic(collections.ErrIndexOutOfBounds)
 	}
-	*s.backingSlice = append((*s.backingSlice)[:s.index], (*s.backingSlice)[s.index+1:]...)
+	*s.backingSlice = append((*s.backingSlice)[:s.lastReturned], (*s.backingSlice)[s.lastReturned+1:]...)
+	// If the element was returned by Next(), the following elements have shifted forward onto the cursor.
+	if s.lastReturned < s.cursor {
+		s.cursor--
+	}
+	s.lastReturned = -1
+	s.modified()
+}
+
+// Set replaces the element last returned by Next() or Previous() in the underlying Slice. If no element has been
+// returned yet, or Remove() or Add() has been called since, an ErrIndexOutOfBounds is thrown in a panic.
+func (s *Iterator[E]) Set(e E) {
+	s.checkModification()
+	if s.lastReturned < 0 {
+		panic(collections.ErrIndexOutOfBounds)
+	}
+	(*s.backingSlice)[s.lastReturned] = e
+}
+
+// Add inserts the element into the underlying Slice before the cursor of the iterator.
+func (s *Iterator[E]) Add(e E) {
+	s.checkModification()
+	s.backingSlice.AddAt(uint(s.cursor), e)
+	s.cursor++
+	s.lastReturned = -1
+	s.modified()
 }
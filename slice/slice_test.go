@@ -1,11 +1,13 @@
 package slice_test
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/apitalist/collections"
 	"github.com/apitalist/collections/slice"
+	"github.com/apitalist/lang"
 	"github.com/apitalist/lang/try"
 	"github.com/apitalist/lang/try/catch"
 )
@@ -252,6 +254,20 @@ func ExampleSlice_Get() {
 	// Output: b
 }
 
+func ExampleSlice_TryGet() {
+	list := slice.New[string]("a", "b", "c")
+
+	if item, ok := list.TryGet(1); ok {
+		fmt.Println(item)
+	}
+	if _, ok := list.TryGet(3); !ok {
+		fmt.Println("The list has no item at index 3.")
+	}
+
+	// Output: b
+	// The list has no item at index 3.
+}
+
 func ExampleSlice_Set() {
 	list := slice.New[string]("a", "b", "c")
 	list.Set(1, "d")
@@ -303,6 +319,30 @@ func ExampleSlice_LastIndexOf() {
 	// Output: 3
 }
 
+func ExampleSlice_Find() {
+	list := slice.New[string]("a", "b", "c", "b", "d")
+
+	if index, ok := list.Find("b"); ok {
+		fmt.Println(index)
+	}
+	if _, ok := list.Find("e"); !ok {
+		fmt.Println("The list does not contain 'e'.")
+	}
+
+	// Output: 1
+	// The list does not contain 'e'.
+}
+
+func ExampleSlice_FindLast() {
+	list := slice.New[string]("a", "b", "c", "b", "d")
+
+	if index, ok := list.FindLast("b"); ok {
+		fmt.Println(index)
+	}
+
+	// Output: 3
+}
+
 func ExampleSlice_IsEmpty() {
 	list1 := slice.New[string]("a", "b", "c")
 	if list1.IsEmpty() {
@@ -445,3 +485,131 @@ func ExampleIterator_Remove() {
 
 	// Output: [a, c]
 }
+
+func ExampleIterator_Remove_beforeNext() {
+	list := slice.New("a", "b", "c")
+
+	// Removing before an element has been returned results in an error:
+	err := lang.Safe(
+		func() {
+			list.MutableIterator().Remove()
+		},
+	)
+	fmt.Println(errors.Is(err, collections.ErrIndexOutOfBounds))
+
+	// Output: true
+}
+
+func ExampleIterator_Remove_consecutive() {
+	list := slice.New[string]("a", "b", "b", "c")
+
+	// Removing through the iterator does not invalidate it, and consecutive elements are not skipped.
+	iterator := list.MutableIterator()
+	for iterator.HasNext() {
+		if iterator.Next() == "b" {
+			iterator.Remove()
+		}
+	}
+	fmt.Println(list)
+
+	// Output: [a, c]
+}
+
+func ExampleSlice_ListIterator() {
+	list := slice.New[string]("a", "b", "c")
+
+	// Start at the end of the list and walk backwards:
+	iterator := list.ListIterator(list.Size())
+	for iterator.HasPrevious() {
+		index := iterator.PreviousIndex()
+		item := iterator.Previous()
+		fmt.Printf("%d: %s\n", index, item)
+		if item == "b" {
+			// Replace the current element.
+			iterator.Set("B")
+		}
+	}
+
+	// Now walk forward and insert an element after "a":
+	for iterator.HasNext() {
+		if iterator.Next() == "a" {
+			iterator.Add("a2")
+		}
+	}
+
+	fmt.Println(list)
+
+	// Output: 2: c
+	// 1: b
+	// 0: a
+	// [a, a2, B, c]
+}
+
+func ExampleSlice_ListIterator_outOfBounds() {
+	list := slice.New("a", "b", "c")
+	iterator := list.ListIterator(0)
+
+	// Moving before the start of the list results in an error:
+	err := lang.Safe(
+		func() {
+			_ = iterator.Previous()
+		},
+	)
+	var indexErr collections.IndexOutOfBoundsError
+	if errors.As(err, &indexErr) {
+		fmt.Printf("Index %d requested from a list of size %d.\n", indexErr.Index, indexErr.Size)
+	}
+
+	// Setting an element before one has been returned is not an index problem, so no index is reported:
+	err = lang.Safe(
+		func() {
+			iterator.Set("d")
+		},
+	)
+	fmt.Println(errors.As(err, &indexErr), errors.Is(err, collections.ErrIndexOutOfBounds))
+
+	// Output: Index -1 requested from a list of size 3.
+	// false true
+}
+
+func ExampleSlice_All() {
+	list := slice.New("a", "b", "c")
+
+	for item := range list.All() {
+		fmt.Println(item)
+	}
+
+	// Output: a
+	// b
+	// c
+}
+
+func ExampleSlice_Backward() {
+	list := slice.New("a", "b", "c")
+
+	for item := range list.Backward() {
+		fmt.Println(item)
+	}
+
+	// Output: c
+	// b
+	// a
+}
+
+func ExampleSlice_SubList_toEnd() {
+	list := slice.New(1, 2, 3, 4)
+
+	// The end index is exclusive, so the size of the list can be passed to include the last element:
+	fmt.Println(list.SubList(2, list.Size()))
+
+	// Passing an end index beyond the size of the list results in an error:
+	err := lang.Safe(
+		func() {
+			list.SubList(2, list.Size()+1)
+		},
+	)
+	fmt.Println(err)
+
+	// Output: [3, 4]
+	// index out of bounds: index 5, size 4
+}
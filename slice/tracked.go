@@ -0,0 +1,247 @@
+package slice
+
+import (
+	"iter"
+
+	"github.com/apitalist/collections"
+	"github.com/apitalist/collections/stream"
+)
+
+// NewTracked creates a new slice-backed list that keeps track of its modifications, optionally filled with the
+// specified elements. Like the Slice, Tracked lists are not concurrency-safe.
+func NewTracked[E comparable](elements ...E) *Tracked[E] {
+	return &Tracked[E]{
+		slice: *New(elements...),
+	}
+}
+
+// Tracked is a Slice that counts its modifications. Its iterators panic with a collections.ErrConcurrentModification
+// if the list is modified other than through the iterator itself, instead of skipping or repeating elements.
+//
+// In order to guarantee this type works correctly, you should always use it as a pointer created by NewTracked().
+// Modifications made to the raw slice returned from ToSlice() are not tracked.
+type Tracked[E comparable] struct {
+	slice Slice[E]
+	// modCount is incremented on every change to the size or the order of the elements.
+	modCount uint
+}
+
+// Stream creates a processing stream from the current list.
+func (t *Tracked[E]) Stream() collections.Stream[E] {
+	return stream.FromCollection[E](t)
+}
+
+// Iterator returns an iterator to loop over the elements. If the list is modified while iterating, the iterator panics
+// with a collections.ErrConcurrentModification.
+func (t *Tracked[E]) Iterator() collections.Iterator[E] {
+	return newIterator(&t.slice, &t.modCount, 0)
+}
+
+// MutableIterator returns a mutable iterator to loop over the elements. If the list is modified while iterating other
+// than through the Remove() function of the iterator, the iterator panics with a collections.ErrConcurrentModification.
+func (t *Tracked[E]) MutableIterator() collections.MutableIterator[E] {
+	return newIterator(&t.slice, &t.modCount, 0)
+}
+
+// ListIterator returns an iterator that can move in both directions and modify the list, starting before the element
+// at the specified index. If the index is larger than the list size, a collections.ErrIndexOutOfBounds is thrown in a
+// panic. If the list is modified while iterating other than through the iterator, the iterator panics with a
+// collections.ErrConcurrentModification.
+func (t *Tracked[E]) ListIterator(start uint) collections.ListIterator[E] {
+	if start > t.slice.Size() {
+		panic(collections.IndexOutOfBoundsError{Index: int(start), Size: t.slice.Size()})
+	}
+	return newIterator(&t.slice, &t.modCount, int(start))
+}
+
+// All returns an iterator function over the elements of the list for use in a for-range loop. If the list is modified
+// during the loop, a collections.ErrConcurrentModification is thrown in a panic.
+func (t *Tracked[E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		iterator := t.Iterator()
+		for iterator.HasNext() {
+			if !yield(iterator.Next()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator function over the elements of the list in reverse order for use in a for-range loop. If
+// the list is modified during the loop, a collections.ErrConcurrentModification is thrown in a panic.
+func (t *Tracked[E]) Backward() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		iterator := t.ListIterator(t.slice.Size())
+		for iterator.HasPrevious() {
+			if !yield(iterator.Previous()) {
+				return
+			}
+		}
+	}
+}
+
+// IsEmpty returns true if the current list is empty.
+func (t *Tracked[E]) IsEmpty() bool {
+	return t.slice.IsEmpty()
+}
+
+// Size returns the number of elements in the list.
+func (t *Tracked[E]) Size() uint {
+	return t.slice.Size()
+}
+
+// ToSlice returns the underlying raw slice. Modifications to this underlying slice will translate to the current list,
+// but are not tracked.
+func (t *Tracked[E]) ToSlice() []E {
+	return t.slice.ToSlice()
+}
+
+// Contains will return true if the specified element is contained within the list.
+func (t *Tracked[E]) Contains(e E) bool {
+	return t.slice.Contains(e)
+}
+
+// Get will return the element at the specified index. If the index is larger than the number of elements, a
+// collections.ErrIndexOutOfBounds is thrown in a panic.
+func (t *Tracked[E]) Get(index uint) E {
+	return t.slice.Get(index)
+}
+
+// TryGet returns the element at the specified index and true. If the index is larger than the number of elements, the
+// zero value and false is returned.
+func (t *Tracked[E]) TryGet(index uint) (E, bool) {
+	return t.slice.TryGet(index)
+}
+
+// IndexOf returns the index of the first element that matches the specified element. If no element is found,
+// a collections.ErrElementNotFound is thrown in a panic.
+func (t *Tracked[E]) IndexOf(e E) uint {
+	return t.slice.IndexOf(e)
+}
+
+// Find returns the index of the first element that matches the specified element and true. If no element is found, 0
+// and false is returned.
+func (t *Tracked[E]) Find(e E) (uint, bool) {
+	return t.slice.Find(e)
+}
+
+// LastIndexOf returns the index of the last element that matches the specified element. If no element is found,
+// a collections.ErrElementNotFound is thrown in a panic.
+func (t *Tracked[E]) LastIndexOf(e E) uint {
+	return t.slice.LastIndexOf(e)
+}
+
+// FindLast returns the index of the last element that matches the specified element and true. If no element is found,
+// 0 and false is returned.
+func (t *Tracked[E]) FindLast(e E) (uint, bool) {
+	return t.slice.FindLast(e)
+}
+
+// SubList will return a copy of a part of the current list from the from index (inclusive) up until the to index
+// (exclusive) as a new Tracked list. If the specified bounds are invalid, a collections.ErrIndexOutOfBounds is thrown
+// in a panic.
+func (t *Tracked[E]) SubList(from, to uint) collections.MutableList[E] {
+	return &Tracked[E]{
+		slice: *t.slice.SubList(from, to).(*Slice[E]),
+	}
+}
+
+// Add adds a new element to the list.
+func (t *Tracked[E]) Add(e E) {
+	t.slice.Add(e)
+	t.modCount++
+}
+
+// AddAll adds all elements from the passed collection to the current list.
+func (t *Tracked[E]) AddAll(c collections.Collection[E]) {
+	iterator := c.Iterator()
+	for iterator.HasNext() {
+		t.Add(iterator.Next())
+	}
+}
+
+// Clear removes all elements from the list.
+func (t *Tracked[E]) Clear() {
+	t.slice.Clear()
+	t.modCount++
+}
+
+// Remove removes all occurrences of the specified element from the list.
+func (t *Tracked[E]) Remove(e E) {
+	t.trackSizeChange(
+		func() {
+			t.slice.Remove(e)
+		},
+	)
+}
+
+// RemoveAll removes all elements contained in the passed collection from the list.
+func (t *Tracked[E]) RemoveAll(c collections.Collection[E]) {
+	t.trackSizeChange(
+		func() {
+			t.slice.RemoveAll(c)
+		},
+	)
+}
+
+// RemoveIf removes all elements matching the predicate from the list.
+func (t *Tracked[E]) RemoveIf(p collections.Predicate[E]) {
+	t.trackSizeChange(
+		func() {
+			t.slice.RemoveIf(p)
+		},
+	)
+}
+
+// RetainAll removes all elements not contained in the passed collection from the list.
+func (t *Tracked[E]) RetainAll(c collections.Collection[E]) {
+	t.trackSizeChange(
+		func() {
+			t.slice.RetainAll(c)
+		},
+	)
+}
+
+// AddAt inserts the element at the specified index. If the index is larger than the list size, a
+// collections.ErrIndexOutOfBounds is thrown in a panic.
+func (t *Tracked[E]) AddAt(index uint, element E) collections.MutableList[E] {
+	t.slice.AddAt(index, element)
+	t.modCount++
+	return t
+}
+
+// Set sets the element at index to the specified value. If the specified index is not found, an ErrIndexOutOfBounds
+// is thrown in a panic. Replacing an element does not invalidate iterators.
+func (t *Tracked[E]) Set(index uint, element E) collections.MutableList[E] {
+	t.slice.Set(index, element)
+	return t
+}
+
+// Sort sorts the list according to the comparator passed as the argument.
+func (t *Tracked[E]) Sort(f collections.Comparator[E]) collections.MutableList[E] {
+	t.slice.Sort(f)
+	t.modCount++
+	return t
+}
+
+// RemoveAt removes the element at the specified index. If the specified index does not exist a
+// collections.ErrIndexOutOfBounds is thrown in a panic.
+func (t *Tracked[E]) RemoveAt(index uint) collections.MutableList[E] {
+	t.slice.RemoveAt(index)
+	t.modCount++
+	return t
+}
+
+// String creates a printable string with brackets and comma-delimiters from the current list.
+func (t *Tracked[E]) String() string {
+	return t.slice.String()
+}
+
+// trackSizeChange runs the modification and counts it if it changed the size of the list.
+func (t *Tracked[E]) trackSizeChange(modification func()) {
+	size := len(t.slice)
+	modification()
+	if len(t.slice) != size {
+		t.modCount++
+	}
+}
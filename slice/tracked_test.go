@@ -0,0 +1,133 @@
+package slice_test
+
+import (
+	"fmt"
+
+	"github.com/apitalist/collections"
+	"github.com/apitalist/collections/slice"
+	"github.com/apitalist/lang"
+	"github.com/apitalist/lang/try"
+	"github.com/apitalist/lang/try/catch"
+)
+
+func ExampleNewTracked() {
+	list := slice.NewTracked("a", "b", "c")
+
+	// Modifying the list through the iterator is allowed:
+	iterator := list.MutableIterator()
+	for iterator.HasNext() {
+		if iterator.Next() == "b" {
+			iterator.Remove()
+		}
+	}
+	fmt.Println(list)
+
+	// Output: [a, c]
+}
+
+func ExampleTracked_concurrentModification() {
+	list := slice.NewTracked[string]("a", "b", "c")
+
+	iterator := list.Iterator()
+	fmt.Println(iterator.Next())
+
+	// Modifying the list outside the iterator invalidates the iterator.
+	list.Add("d")
+
+	try.Catch(
+		func() {
+			_ = iterator.Next()
+		},
+		catch.ErrorByValue(
+			collections.ErrConcurrentModification, func(_ error) {
+				fmt.Println("The list has been modified!")
+			},
+		),
+	)
+
+	// Output: a
+	// The list has been modified!
+}
+
+func ExampleTracked_concurrentModificationKeepingSize() {
+	list := slice.NewTracked(1, 2, 3, 4)
+
+	// Modifications that leave the size of the list unchanged also invalidate the iterator.
+	iterator := list.Iterator()
+	fmt.Println(iterator.Next())
+	list.RemoveAt(0)
+	list.Add(99)
+
+	err := lang.Safe(
+		func() {
+			_ = iterator.Next()
+		},
+	)
+	fmt.Println(err)
+
+	iterator = list.Iterator()
+	list.RemoveAt(3)
+	list.AddAt(0, 42)
+
+	err = lang.Safe(
+		func() {
+			_ = iterator.Next()
+		},
+	)
+	fmt.Println(err)
+
+	// Output: 1
+	// concurrent modification
+	// concurrent modification
+}
+
+func ExampleTracked_ListIterator() {
+	list := slice.NewTracked("a", "c")
+
+	// Other iterators are invalidated by modifications through an iterator:
+	iterator := list.ListIterator(1)
+	other := list.Iterator()
+	iterator.Add("b")
+	fmt.Println(list)
+
+	err := lang.Safe(
+		func() {
+			_ = other.Next()
+		},
+	)
+	fmt.Println(err)
+
+	// Output: [a, b, c]
+	// concurrent modification
+}
+
+func ExampleTracked_All() {
+	list := slice.NewTracked(1, 2, 3)
+
+	// Modifying the list in a for-range loop results in an error:
+	err := lang.Safe(
+		func() {
+			for e := range list.All() {
+				if e == 1 {
+					list.Remove(e)
+				}
+			}
+		},
+	)
+	fmt.Println(err)
+	fmt.Println(list)
+
+	// Output: concurrent modification
+	// [2, 3]
+}
+
+func ExampleTracked_Stream() {
+	list := slice.NewTracked(1, 2, 3, 4)
+
+	// The tracked list can be used like any other list:
+	fmt.Println(list.SubList(1, 3))
+	fmt.Println(list.Stream().Filter(func(i int) bool { return i%2 == 0 }).ToSlice())
+
+	// Output: [2, 3]
+	// [2 4]
+}
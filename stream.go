@@ -1,12 +1,26 @@
 package collections
 
+import (
+	"io"
+	"iter"
+)
+
 // Stream is an interface that describes a process for streaming data, one by one. The functions in this interface can
 // be used to add more processing elements to a stream.
 //
-// Each stream must be terminated by a terminal function, such as AllMatch, AnyMatch, ToSlice, etc in order to ensure
-// no resources are left dangling. Individual stream items should also not be used more than once, which can lead to
-// unpredictable behavior.
+// Each stream must be terminated by a terminal function, such as AllMatch, AnyMatch, ToSlice, etc, or closed using
+// Close() in order to ensure no resources are left dangling. Individual stream items should also not be used more than
+// once, which can lead to unpredictable behavior.
+//
+// A stream can only be used once. Calling a terminal or intermediate element on a stream that has already been used
+// or closed results in an ErrStreamConsumed thrown in a panic. Functions that return an error return
+// ErrStreamConsumed instead.
 type Stream[T any] interface {
+	// Close stops processing the stream and releases all resources held by the stream and the upstream stages. Terminal
+	// elements close the stream automatically, so this is only needed if a stream is abandoned without calling a
+	// terminal element. It is safe to call Close more than once.
+	io.Closer
+
 	// AllMatch returns true if the predicate returns true for all items in the stream.
 	//
 	// This is a terminal element in the stream.
@@ -44,7 +58,27 @@ type Stream[T any] interface {
 	// function without a receiver.
 	Map(func(T) T) Stream[T]
 
+	// OnClose registers a handler function that is run when the stream is closed. This happens when a terminal element
+	// completes, when the iterator returned from Iterator() is closed or runs out of elements, when Close() is called,
+	// or when the stream processing fails. Each handler is run exactly once. When a stream is closed, the handlers of
+	// all upstream stages are run first, then the handlers of the current stream in the order they were registered. If
+	// the stream is already closed, the handler is run immediately.
+	//
+	// OnClose returns the current stream and does not count as a use of the stream.
+	OnClose(func()) Stream[T]
+
 	// Iterator returns an iterator that loops over the stream. Please note that Close() must be called on the iterator
 	// to properly close the stream.
 	Iterator() IteratorCloser[T]
+
+	// All returns an iterator function for use in a for-range loop. The stream is consumed when the loop starts and
+	// closed when the loop ends, including when the loop is stopped early using break. Errors passed from upstream
+	// elements are thrown in a panic inside the loop:
+	//
+	//     for item := range s.All() {
+	//         // Use item here
+	//     }
+	//
+	// This is a terminal element in the stream.
+	All() iter.Seq[T]
 }
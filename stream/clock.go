@@ -0,0 +1,21 @@
+package stream
+
+import "time"
+
+// Clock provides the current time to time-based stream functions. You can pass your own implementation to make these
+// functions deterministic in tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+}
+
+// SystemClock returns a Clock that provides the current system time.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+type systemClock struct{}
+
+func (s systemClock) Now() time.Time {
+	return time.Now()
+}
@@ -0,0 +1,84 @@
+package stream
+
+import (
+	"encoding/gob"
+	"io"
+)
+
+// Codec creates encoders and decoders for stream elements. It is used by operations that need to store stream
+// elements outside the memory, such as ExternalSort.
+type Codec[T any] interface {
+	// NewEncoder creates an encoder that writes elements to the specified writer.
+	NewEncoder(w io.Writer) Encoder[T]
+
+	// NewDecoder creates a decoder that reads elements from the specified reader.
+	NewDecoder(r io.Reader) Decoder[T]
+}
+
+// Encoder writes individual elements to an underlying writer.
+type Encoder[T any] interface {
+	// Encode writes the passed element.
+	Encode(T) error
+}
+
+// Decoder reads individual elements from an underlying reader.
+type Decoder[T any] interface {
+	// Decode reads the next element. If no more elements are remaining, io.EOF is returned.
+	Decode() (T, error)
+}
+
+// NewCodec creates a codec from a pair of encoder and decoder factory functions. This lets you plug in your own
+// Encoder and Decoder implementations.
+func NewCodec[T any](
+	newEncoder func(w io.Writer) Encoder[T],
+	newDecoder func(r io.Reader) Decoder[T],
+) Codec[T] {
+	return &codec[T]{
+		newEncoder: newEncoder,
+		newDecoder: newDecoder,
+	}
+}
+
+// GobCodec returns a codec that uses encoding/gob to encode the elements. Elements must be encodable with gob, e.g.
+// structs must have exported fields.
+func GobCodec[T any]() Codec[T] {
+	return NewCodec(
+		func(w io.Writer) Encoder[T] {
+			return &gobEncoder[T]{gob.NewEncoder(w)}
+		},
+		func(r io.Reader) Decoder[T] {
+			return &gobDecoder[T]{gob.NewDecoder(r)}
+		},
+	)
+}
+
+type codec[T any] struct {
+	newEncoder func(w io.Writer) Encoder[T]
+	newDecoder func(r io.Reader) Decoder[T]
+}
+
+func (c codec[T]) NewEncoder(w io.Writer) Encoder[T] {
+	return c.newEncoder(w)
+}
+
+func (c codec[T]) NewDecoder(r io.Reader) Decoder[T] {
+	return c.newDecoder(r)
+}
+
+type gobEncoder[T any] struct {
+	encoder *gob.Encoder
+}
+
+func (g gobEncoder[T]) Encode(e T) error {
+	return g.encoder.Encode(&e)
+}
+
+type gobDecoder[T any] struct {
+	decoder *gob.Decoder
+}
+
+func (g gobDecoder[T]) Decode() (T, error) {
+	var e T
+	err := g.decoder.Decode(&e)
+	return e, err
+}
@@ -0,0 +1,170 @@
+package stream
+
+import (
+	"slices"
+
+	"github.com/apitalist/collections"
+)
+
+// CartesianProduct creates a stream of all tuples that take one element from each of the passed collections, in the
+// order of the collections. The tuples are generated lazily in lexicographic order of the element positions, with the
+// last collection varying fastest. The elements of the collections are copied when the stream is created. If any
+// collection is empty, the stream is empty. If no collections are passed, the stream contains a single empty tuple.
+func CartesianProduct[T comparable](c ...collections.Collection[T]) collections.Stream[[]T] {
+	pools := make([][]T, len(c))
+	for i, collection := range c {
+		pools[i] = slices.Clone(collection.ToSlice())
+	}
+	return newStream[[]T](
+		newStage("CartesianProduct"),
+		func(emit func([]T) bool) error {
+			for _, pool := range pools {
+				if len(pool) == 0 {
+					return nil
+				}
+			}
+			indexes := make([]int, len(pools))
+			for {
+				tuple := make([]T, len(pools))
+				for i, index := range indexes {
+					tuple[i] = pools[i][index]
+				}
+				if !emit(tuple) {
+					return nil
+				}
+				// Advance the indexes like an odometer, starting with the last position.
+				i := len(indexes) - 1
+				for ; i >= 0; i-- {
+					indexes[i]++
+					if indexes[i] < len(pools[i]) {
+						break
+					}
+					indexes[i] = 0
+				}
+				if i < 0 {
+					return nil
+				}
+			}
+		},
+	)
+}
+
+// Combinations creates a stream of all subsets with exactly k elements of the passed collection, without repetition.
+// The elements keep the order of the collection, and the subsets are generated lazily in lexicographic order of the
+// element positions. The elements of the collection are copied when the stream is created. If k is larger than the
+// size of the collection, the stream is empty.
+func Combinations[T comparable](c collections.Collection[T], k uint) collections.Stream[[]T] {
+	pool := slices.Clone(c.ToSlice())
+	return newStream[[]T](
+		newStage("Combinations"),
+		func(emit func([]T) bool) error {
+			// k is compared before the conversion, since a large k would overflow the int.
+			if k > uint(len(pool)) {
+				return nil
+			}
+			combinations(pool, int(k), emit)
+			return nil
+		},
+	)
+}
+
+// PowerSet creates a stream of all subsets of the passed collection, starting with the empty set and followed by the
+// subsets with increasing size, in the same order as Combinations. The elements of the collection are copied when the
+// stream is created.
+func PowerSet[T comparable](c collections.Collection[T]) collections.Stream[[]T] {
+	pool := slices.Clone(c.ToSlice())
+	return newStream[[]T](
+		newStage("PowerSet"),
+		func(emit func([]T) bool) error {
+			for k := 0; k <= len(pool); k++ {
+				if !combinations(pool, k, emit) {
+					return nil
+				}
+			}
+			return nil
+		},
+	)
+}
+
+// combinations emits all combinations of k elements from the pool. It returns false if emit returned false.
+func combinations[T any](pool []T, k int, emit func([]T) bool) bool {
+	n := len(pool)
+	if k > n {
+		return true
+	}
+	indexes := make([]int, k)
+	for i := range indexes {
+		indexes[i] = i
+	}
+	for {
+		combination := make([]T, k)
+		for i, index := range indexes {
+			combination[i] = pool[index]
+		}
+		if !emit(combination) {
+			return false
+		}
+		// Find the rightmost index that can be moved to the right, then reset all indexes after it.
+		i := k - 1
+		for i >= 0 && indexes[i] == i+n-k {
+			i--
+		}
+		if i < 0 {
+			return true
+		}
+		indexes[i]++
+		for j := i + 1; j < k; j++ {
+			indexes[j] = indexes[j-1] + 1
+		}
+	}
+}
+
+// Permutations creates a stream of all orderings of the elements of the passed collection. The permutations are
+// generated lazily in lexicographic order of the element positions, starting with the order of the collection.
+// Repeated elements are treated as distinct, so the stream may contain equal permutations. The elements of the
+// collection are copied when the stream is created.
+func Permutations[T comparable](c collections.Collection[T]) collections.Stream[[]T] {
+	pool := slices.Clone(c.ToSlice())
+	return newStream[[]T](
+		newStage("Permutations"),
+		func(emit func([]T) bool) error {
+			indexes := make([]int, len(pool))
+			for i := range indexes {
+				indexes[i] = i
+			}
+			for {
+				permutation := make([]T, len(pool))
+				for i, index := range indexes {
+					permutation[i] = pool[index]
+				}
+				if !emit(permutation) {
+					return nil
+				}
+				if !nextPermutation(indexes) {
+					return nil
+				}
+			}
+		},
+	)
+}
+
+// nextPermutation rearranges the indexes into the next lexicographically larger permutation. It returns false if the
+// indexes are already the largest permutation.
+func nextPermutation(indexes []int) bool {
+	i := len(indexes) - 2
+	for i >= 0 && indexes[i] >= indexes[i+1] {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+	j := len(indexes) - 1
+	for indexes[j] <= indexes[i] {
+		j--
+	}
+	indexes[i], indexes[j] = indexes[j], indexes[i]
+	for l, r := i+1, len(indexes)-1; l < r; l, r = l+1, r-1 {
+		indexes[l], indexes[r] = indexes[r], indexes[l]
+	}
+	return true
+}
@@ -0,0 +1,112 @@
+package stream_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/apitalist/collections/slice"
+	"github.com/apitalist/collections/stream"
+)
+
+func ExampleCartesianProduct() {
+	tuples := stream.CartesianProduct[string](
+		slice.New("linux", "windows"),
+		slice.New("amd64", "arm64"),
+	).ToSlice()
+	for _, tuple := range tuples {
+		fmt.Println(tuple)
+	}
+
+	// Output: [linux amd64]
+	// [linux arm64]
+	// [windows amd64]
+	// [windows arm64]
+}
+
+func ExampleCombinations() {
+	combinations := stream.Combinations[int](slice.New(1, 2, 3, 4), 2).ToSlice()
+	fmt.Println(combinations)
+
+	// Output: [[1 2] [1 3] [1 4] [2 3] [2 4] [3 4]]
+}
+
+func ExamplePowerSet() {
+	subsets := stream.PowerSet[string](slice.New("a", "b", "c")).ToSlice()
+	fmt.Println(subsets)
+
+	// Output: [[] [a] [b] [c] [a b] [a c] [b c] [a b c]]
+}
+
+func ExamplePermutations() {
+	permutations := stream.Permutations[int](slice.New(1, 2, 3)).ToSlice()
+	fmt.Println(permutations)
+
+	// Output: [[1 2 3] [1 3 2] [2 1 3] [2 3 1] [3 1 2] [3 2 1]]
+}
+
+func ExamplePermutations_findFirst() {
+	// The permutations are generated lazily, so only the permutations up to the first match are created, even though
+	// there are more than 6 billion permutations in total.
+	letters := slice.New("a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m")
+	first := stream.Permutations[string](letters).Filter(
+		func(p []string) bool {
+			return p[len(p)-1] == "l"
+		},
+	).FindFirst()
+	fmt.Println(first)
+
+	// Output: [a b c d e f g h i j k m l]
+}
+
+func TestCombinatoricsEdgeCases(t *testing.T) {
+	if n := stream.CartesianProduct[int]().Count(); n != 1 {
+		t.Fatalf("expected a single empty tuple for no collections, got %d tuples", n)
+	}
+	if n := stream.CartesianProduct[int](slice.New(1, 2), slice.New[int]()).Count(); n != 0 {
+		t.Fatalf("expected no tuples if a collection is empty, got %d", n)
+	}
+	if n := stream.Combinations[int](slice.New(1, 2), 3).Count(); n != 0 {
+		t.Fatalf("expected no combinations if k is larger than the collection, got %d", n)
+	}
+	if n := stream.Combinations[int](slice.New(1, 2), 0).Count(); n != 1 {
+		t.Fatalf("expected a single empty combination if k is 0, got %d", n)
+	}
+	if n := stream.Combinations[int](slice.New(1, 2), ^uint(0)).Count(); n != 0 {
+		t.Fatalf("expected no combinations for the largest k, got %d", n)
+	}
+	if n := stream.PowerSet[int](slice.New(1, 2, 3, 4, 5)).Count(); n != 32 {
+		t.Fatalf("expected 32 subsets, got %d", n)
+	}
+	if n := stream.Permutations[int](slice.New[int]()).Count(); n != 1 {
+		t.Fatalf("expected a single empty permutation, got %d", n)
+	}
+	if n := stream.Permutations[int](slice.New(1, 2, 3, 4, 5)).Count(); n != 120 {
+		t.Fatalf("expected 120 permutations, got %d", n)
+	}
+}
+
+func TestCombinatoricsCopySource(t *testing.T) {
+	list := slice.New(1, 2, 3)
+	product := stream.CartesianProduct[int](list)
+	combinations := stream.Combinations[int](list, 1)
+	powerSet := stream.PowerSet[int](list)
+	permutations := stream.Permutations[int](list)
+
+	// Changes to the source after creating the streams must not affect them.
+	list.Set(0, 99)
+	list.RemoveAt(2)
+
+	for name, tc := range map[string]struct {
+		s        interface{ ToSlice() [][]int }
+		expected string
+	}{
+		"CartesianProduct": {product, "[[1] [2] [3]]"},
+		"Combinations":     {combinations, "[[1] [2] [3]]"},
+		"PowerSet":         {powerSet, "[[] [1] [2] [3] [1 2] [1 3] [2 3] [1 2 3]]"},
+		"Permutations":     {permutations, "[[1 2 3] [1 3 2] [2 1 3] [2 3 1] [3 1 2] [3 2 1]]"},
+	} {
+		if result := fmt.Sprint(tc.s.ToSlice()); result != tc.expected {
+			t.Fatalf("%s: expected %s, got %s", name, tc.expected, result)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package stream_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/apitalist/collections"
+	"github.com/apitalist/collections/stream"
+	"github.com/apitalist/lang"
+	"github.com/apitalist/lang/try"
+	"github.com/apitalist/lang/try/catch"
+)
+
+func Example_consumed() {
+	s := stream.Of(1, 2, 3)
+	fmt.Println(s.Count())
+
+	// Streams can only be used once:
+	try.Catch(
+		func() {
+			s.Count()
+		},
+		catch.ErrorByValue(
+			collections.ErrStreamConsumed, func(_ error) {
+				fmt.Println("The stream has already been consumed.")
+			},
+		),
+	)
+
+	// Output: 3
+	// The stream has already been consumed.
+}
+
+func TestConsumedStreamsPanic(t *testing.T) {
+	operations := map[string]func(s collections.Stream[int]){
+		"AllMatch":  func(s collections.Stream[int]) { s.AllMatch(func(int) bool { return true }) },
+		"AnyMatch":  func(s collections.Stream[int]) { s.AnyMatch(func(int) bool { return true }) },
+		"Count":     func(s collections.Stream[int]) { s.Count() },
+		"Filter":    func(s collections.Stream[int]) { s.Filter(func(int) bool { return true }) },
+		"FindFirst": func(s collections.Stream[int]) { s.FindFirst() },
+		"Iterator":  func(s collections.Stream[int]) { s.Iterator() },
+		"Map":       func(s collections.Stream[int]) { s.Map(func(i int) int { return i }) },
+		"ToSlice":   func(s collections.Stream[int]) { s.ToSlice() },
+		"stream.Map": func(s collections.Stream[int]) {
+			stream.Map(s, func(i int) int { return i })
+		},
+	}
+	for firstName, first := range operations {
+		for secondName, second := range operations {
+			t.Run(
+				fmt.Sprintf("%s after %s", secondName, firstName), func(t *testing.T) {
+					s := stream.Of(1, 2, 3)
+					first(s)
+					err := lang.Safe(
+						func() {
+							second(s)
+						},
+					)
+					if !errors.Is(err, collections.ErrStreamConsumed) {
+						t.Fatalf("expected ErrStreamConsumed, got %v", err)
+					}
+				},
+			)
+		}
+	}
+}
+
+func TestClosedStreamsPanic(t *testing.T) {
+	s := stream.Of(1, 2, 3)
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	err := lang.Safe(
+		func() {
+			s.ToSlice()
+		},
+	)
+	if !errors.Is(err, collections.ErrStreamConsumed) {
+		t.Fatalf("expected ErrStreamConsumed, got %v", err)
+	}
+}
@@ -0,0 +1,271 @@
+package stream
+
+import (
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+
+	"github.com/apitalist/collections"
+)
+
+// FromCSV creates a stream of the records read from the CSV reader. If a record cannot be read, a DecodeError is
+// passed down the stream.
+func FromCSV(r *csv.Reader) collections.Stream[[]string] {
+	return newStream[[]string](
+		newStage("FromCSV"),
+		func(emit func([]string) bool) error {
+			row := uint(0)
+			for {
+				row++
+				record, err := readCSVRecord(r, row)
+				if err != nil {
+					if errors.Is(err, io.EOF) {
+						return nil
+					}
+					return err
+				}
+				if !emit(record) {
+					return nil
+				}
+			}
+		},
+	)
+}
+
+// DecodeCSV creates a stream of structs from the CSV reader. The first record is used as the header, the fields of
+// the following records are assigned to the struct fields by the header name. The header name of a struct field is
+// taken from the csv struct tag, or the field name if no tag is present. Fields tagged with `csv:"-"` and header names
+// without a matching struct field are ignored.
+//
+// Struct fields may be strings, booleans, integers, floats, or implement encoding.TextUnmarshaler. Empty values leave
+// the struct field unchanged. If a field cannot be decoded, a DecodeError with the row and column is passed down the
+// stream.
+func DecodeCSV[T any](r *csv.Reader) collections.Stream[T] {
+	return newStream[T](
+		newStage("DecodeCSV"),
+		func(emit func(T) bool) error {
+			fields, err := csvFields(reflect.TypeOf((*T)(nil)).Elem())
+			if err != nil {
+				return err
+			}
+			header, err := readCSVRecord(r, 1)
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return err
+			}
+			columns := make([]*csvField, len(header))
+			for i, name := range header {
+				for j := range fields {
+					if fields[j].name == name {
+						columns[i] = &fields[j]
+					}
+				}
+			}
+			row := uint(1)
+			for {
+				row++
+				record, err := readCSVRecord(r, row)
+				if err != nil {
+					if errors.Is(err, io.EOF) {
+						return nil
+					}
+					return err
+				}
+				var e T
+				value := reflect.ValueOf(&e).Elem()
+				for i, field := range record {
+					if i >= len(columns) || columns[i] == nil || field == "" {
+						continue
+					}
+					if err := decodeCSVField(value.FieldByIndex(columns[i].index), field); err != nil {
+						line, _ := r.FieldPos(i)
+						return DecodeError{
+							Line:   uint(line),
+							Row:    row,
+							Column: uint(i + 1),
+							Cause:  fmt.Errorf("invalid value for %s (%w)", columns[i].name, err),
+						}
+					}
+				}
+				if !emit(e) {
+					return nil
+				}
+			}
+		},
+	)
+}
+
+// ToCSV writes the records in the stream to the CSV writer and flushes it. It returns the first error encountered,
+// including errors passed from upstream.
+//
+// This is a terminal function for the stream.
+func ToCSV(w *csv.Writer, s collections.Stream[[]string]) error {
+	if err := tryForEach(s, w.Write); err != nil {
+		return err
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// EncodeCSV writes the structs in the stream to the CSV writer, preceded by a header record, and flushes it. The
+// header names are determined the same way as for DecodeCSV. It returns the first error encountered, including errors
+// passed from upstream.
+//
+// This is a terminal function for the stream.
+func EncodeCSV[T any](w *csv.Writer, s collections.Stream[T]) error {
+	fields, err := csvFields(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		_ = s.Close()
+		return err
+	}
+	// The iterator is obtained first so nothing is written if the stream has already been used.
+	iterator, err := tryIterator(s)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = iterator.Close()
+	}()
+	header := make([]string, len(fields))
+	for i, field := range fields {
+		header[i] = field.name
+	}
+	if err := w.Write(header); err != nil {
+		return err
+	}
+	if err := tryForEachRemaining[T](
+		iterator, func(e T) error {
+			value := reflect.ValueOf(e)
+			record := make([]string, len(fields))
+			for i, field := range fields {
+				var err error
+				if record[i], err = encodeCSVField(value.FieldByIndex(field.index)); err != nil {
+					return fmt.Errorf("failed to encode %s (%w)", field.name, err)
+				}
+			}
+			return w.Write(record)
+		},
+	); err != nil {
+		return err
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// readCSVRecord reads the next record and converts parse errors into a DecodeError.
+func readCSVRecord(r *csv.Reader, row uint) ([]string, error) {
+	record, err := r.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, DecodeError{
+				Line:   uint(parseErr.Line),
+				Row:    row,
+				Column: uint(parseErr.Column),
+				Cause:  err,
+			}
+		}
+		return nil, err
+	}
+	return record, nil
+}
+
+// csvField describes a struct field mapped to a CSV column.
+type csvField struct {
+	name  string
+	index []int
+}
+
+// csvFields returns the CSV fields of the specified struct type.
+func csvFields(t reflect.Type) ([]csvField, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("CSV records can only be mapped to structs, %s given", t)
+	}
+	var fields []csvField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name, ok := field.Tag.Lookup("csv")
+		if !ok || name == "" {
+			name = field.Name
+		}
+		if name == "-" {
+			continue
+		}
+		fields = append(
+			fields, csvField{
+				name:  name,
+				index: field.Index,
+			},
+		)
+	}
+	return fields, nil
+}
+
+// decodeCSVField parses the text and stores it in the passed struct field value.
+func decodeCSVField(value reflect.Value, text string) error {
+	if unmarshaler, ok := value.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(text))
+	}
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(text)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		value.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(text, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, err := strconv.ParseUint(text, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetUint(i)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(text, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported field type: %s", value.Type())
+	}
+	return nil
+}
+
+// encodeCSVField converts the passed struct field value into text.
+func encodeCSVField(value reflect.Value) (string, error) {
+	if marshaler, ok := value.Interface().(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		return string(text), err
+	}
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'g', -1, value.Type().Bits()), nil
+	default:
+		return "", fmt.Errorf("unsupported field type: %s", value.Type())
+	}
+}
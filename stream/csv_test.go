@@ -0,0 +1,123 @@
+package stream_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/apitalist/collections"
+	"github.com/apitalist/collections/stream"
+	"github.com/apitalist/lang"
+)
+
+type product struct {
+	Name     string  `csv:"name"`
+	Price    float64 `csv:"price"`
+	Quantity uint    `csv:"quantity"`
+	Internal string  `csv:"-"`
+}
+
+func ExampleFromCSV() {
+	r := csv.NewReader(strings.NewReader("a,b,c\nd,e,f\n"))
+
+	records := stream.FromCSV(r).ToSlice()
+	fmt.Println(records)
+
+	// Output: [[a b c] [d e f]]
+}
+
+func ExampleDecodeCSV() {
+	r := csv.NewReader(strings.NewReader("name,quantity,price\nbook,2,9.99\npen,10,1.5\n"))
+
+	products := stream.DecodeCSV[product](r).ToSlice()
+	for _, p := range products {
+		fmt.Printf("%d x %s at %.2f\n", p.Quantity, p.Name, p.Price)
+	}
+
+	// Output: 2 x book at 9.99
+	// 10 x pen at 1.50
+}
+
+func ExampleDecodeCSV_error() {
+	r := csv.NewReader(strings.NewReader("name,quantity,price\nbook,2,9.99\npen,ten,1.5\n"))
+
+	err := lang.Safe(
+		func() {
+			stream.DecodeCSV[product](r).ToSlice()
+		},
+	)
+
+	// The decode error contains the row and column of the invalid field:
+	var decodeErr stream.DecodeError
+	if errors.As(err, &decodeErr) {
+		fmt.Printf("Invalid value in row %d, column %d.\n", decodeErr.Row, decodeErr.Column)
+	}
+
+	// Output: Invalid value in row 3, column 2.
+}
+
+func ExampleToCSV() {
+	w := csv.NewWriter(os.Stdout)
+
+	if err := stream.ToCSV(w, stream.Of([]string{"a", "b"}, []string{"c", "d"})); err != nil {
+		panic(err)
+	}
+
+	// Output: a,b
+	// c,d
+}
+
+func ExampleEncodeCSV() {
+	w := csv.NewWriter(os.Stdout)
+
+	if err := stream.EncodeCSV(w, stream.Of(product{"book", 9.99, 2, ""}, product{"pen", 1.5, 10, ""})); err != nil {
+		panic(err)
+	}
+
+	// Output: name,price,quantity
+	// book,9.99,2
+	// pen,1.5,10
+}
+
+func TestFromCSVParseErrorPosition(t *testing.T) {
+	r := csv.NewReader(strings.NewReader("a,b\nc,\"d\"x\n"))
+
+	err := lang.Safe(
+		func() {
+			stream.FromCSV(r).ToSlice()
+		},
+	)
+
+	var decodeErr stream.DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("expected a DecodeError, got %v", err)
+	}
+	if decodeErr.Line != 2 || decodeErr.Row != 2 || decodeErr.Column != 5 {
+		t.Fatalf(
+			"unexpected position: line %d, row %d, column %d (%v)",
+			decodeErr.Line,
+			decodeErr.Row,
+			decodeErr.Column,
+			err,
+		)
+	}
+}
+
+func TestEncodeCSVConsumedStream(t *testing.T) {
+	s := stream.Of(product{Name: "book"})
+	_ = s.ToSlice()
+
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	if err := stream.EncodeCSV(w, s); !errors.Is(err, collections.ErrStreamConsumed) {
+		t.Fatalf("expected ErrStreamConsumed, got %v", err)
+	}
+	w.Flush()
+	if buf.Len() != 0 {
+		t.Fatalf("nothing should have been written to a consumed stream, got %q", buf.String())
+	}
+}
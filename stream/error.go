@@ -0,0 +1,68 @@
+package stream
+
+import "fmt"
+
+// DecodeError is passed down the stream if a source fails to decode an element from its input. It can be retrieved
+// from the recovered panic using errors.As.
+type DecodeError struct {
+	// Line is the line number in the input where the error occurred, starting at 1.
+	Line uint
+	// Row is the number of the record in the input, starting at 1, for record-based inputs such as CSV. It is 0 for
+	// other inputs.
+	Row uint
+	// Column is the number of the field in the record, starting at 1, for record-based inputs such as CSV. If the
+	// input is malformed, for example because of an unterminated quote, it is the byte position in the line reported
+	// by the parser, starting at 1. It is 0 if the error does not belong to a single field or position.
+	Column uint
+	// Cause is the underlying error.
+	Cause error
+}
+
+// Error returns the error message including the position of the error.
+func (d DecodeError) Error() string {
+	position := fmt.Sprintf("line %d", d.Line)
+	if d.Row > 0 {
+		position += fmt.Sprintf(", row %d", d.Row)
+	}
+	if d.Column > 0 {
+		position += fmt.Sprintf(", column %d", d.Column)
+	}
+	return fmt.Sprintf("failed to decode %s (%v)", position, d.Cause)
+}
+
+// Unwrap returns the underlying error.
+func (d DecodeError) Unwrap() error {
+	return d.Cause
+}
+
+// StreamError is passed down the stream if a stage fails, for example because a user-supplied function panics or a
+// source fails to read its input. It describes the stage and the element that caused the failure. The original error
+// can be retrieved using errors.Is, errors.As or Unwrap.
+type StreamError struct {
+	// Stage is the stage in the pipeline that failed.
+	Stage Stage
+	// Input is the index of the input stream the element was received from, for stages with multiple inputs such as
+	// joins, in the order the streams were passed to the stage. It is 0 for all other stages.
+	Input uint
+	// Position is the position of the element the stage was processing when it failed, starting at 0. For stages
+	// consuming other streams, this is the position of the last element received from upstream within its input. For
+	// sources, it is the position of the element that was being produced.
+	Position uint
+	// Cause is the underlying error.
+	Cause error
+}
+
+// Error returns the error message including the stage and element position.
+func (s StreamError) Error() string {
+	if s.Input > 0 {
+		return fmt.Sprintf(
+			"stream stage %s failed at element %d of input %d (%v)", s.Stage, s.Position, s.Input, s.Cause,
+		)
+	}
+	return fmt.Sprintf("stream stage %s failed at element %d (%v)", s.Stage, s.Position, s.Cause)
+}
+
+// Unwrap returns the underlying error.
+func (s StreamError) Unwrap() error {
+	return s.Cause
+}
@@ -0,0 +1,210 @@
+package stream_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/apitalist/collections/stream"
+	"github.com/apitalist/lang"
+)
+
+func ExampleStreamError() {
+	s := stream.Map(
+		stream.Of("1", "2", "three", "4"),
+		func(s string) int {
+			i, err := strconv.Atoi(s)
+			if err != nil {
+				panic(err)
+			}
+			return i
+		},
+	)
+
+	err := lang.Safe(
+		func() {
+			s.ToSlice()
+		},
+	)
+
+	var streamErr stream.StreamError
+	if errors.As(err, &streamErr) {
+		fmt.Printf("Stage %s failed at element %d\n", streamErr.Stage.Name, streamErr.Position)
+	}
+	fmt.Println(errors.Is(err, strconv.ErrSyntax))
+
+	// Output: Stage Map failed at element 2
+	// true
+}
+
+func TestStreamErrorIsNotWrappedDownstream(t *testing.T) {
+	cause := fmt.Errorf("odd number")
+	s := stream.Map(
+		stream.Of(2, 4, 5, 6).Filter(
+			func(i int) bool {
+				if i%2 != 0 {
+					panic(cause)
+				}
+				return true
+			},
+		),
+		func(i int) int {
+			return i * 2
+		},
+	)
+
+	err := lang.Safe(
+		func() {
+			s.ToSlice()
+		},
+	)
+
+	var streamErr stream.StreamError
+	if !errors.As(err, &streamErr) {
+		t.Fatalf("expected a StreamError, got %v", err)
+	}
+	if streamErr.Stage.Name != "Filter" || streamErr.Stage.Index != 1 {
+		t.Fatalf("expected the error to be reported by #1 Filter, got %s", streamErr.Stage)
+	}
+	if streamErr.Position != 2 {
+		t.Fatalf("expected the error at position 2, got %d", streamErr.Position)
+	}
+	if !errors.Is(err, cause) {
+		t.Fatalf("expected the error to wrap the cause, got %v", err)
+	}
+	if errors.As(streamErr.Cause, &stream.StreamError{}) {
+		t.Fatalf("the error has been wrapped multiple times: %v", err)
+	}
+}
+
+func TestStreamErrorPositionOfFailingSource(t *testing.T) {
+	// The position of a failing source must not depend on how far the downstream stages have progressed, so the
+	// pipeline is run repeatedly.
+	for i := 0; i < 500; i++ {
+		s := stream.DecodeJSONLines[map[string]int](strings.NewReader("{\"A\":1}\n{bad}\n")).Filter(
+			func(map[string]int) bool {
+				return true
+			},
+		)
+
+		err := lang.Safe(
+			func() {
+				s.ToSlice()
+			},
+		)
+
+		var streamErr stream.StreamError
+		if !errors.As(err, &streamErr) {
+			t.Fatalf("expected a StreamError, got %v", err)
+		}
+		if streamErr.Stage.Name != "DecodeJSONLines" || streamErr.Position != 1 {
+			t.Fatalf("expected the error at position 1 of DecodeJSONLines, got %v", err)
+		}
+	}
+}
+
+func TestStreamErrorPositionOfJoin(t *testing.T) {
+	failingCombiner := func(l, r int) int {
+		if l == 2 {
+			panic(fmt.Errorf("cannot combine %d", l))
+		}
+		return l + r
+	}
+	err := lang.Safe(
+		func() {
+			stream.InnerJoin(
+				stream.Of(1, 2),
+				stream.Of(1, 2, 3, 4, 5),
+				func(i int) int { return i },
+				func(i int) int { return i },
+				failingCombiner,
+			).ToSlice()
+		},
+	)
+	var streamErr stream.StreamError
+	if !errors.As(err, &streamErr) {
+		t.Fatalf("expected a StreamError, got %v", err)
+	}
+	if streamErr.Input != 0 || streamErr.Position != 1 {
+		t.Fatalf(
+			"expected the error at position 1 of the left input, got input %d, position %d (%v)",
+			streamErr.Input,
+			streamErr.Position,
+			err,
+		)
+	}
+
+	failingRightKey := func(i int) int {
+		if i == 4 {
+			panic(fmt.Errorf("invalid key %d", i))
+		}
+		return i
+	}
+	err = lang.Safe(
+		func() {
+			stream.InnerJoin(
+				stream.Of(1, 2),
+				stream.Of(1, 2, 3, 4, 5),
+				func(i int) int { return i },
+				failingRightKey,
+				func(l, r int) int { return l + r },
+			).ToSlice()
+		},
+	)
+	if !errors.As(err, &streamErr) {
+		t.Fatalf("expected a StreamError, got %v", err)
+	}
+	if streamErr.Input != 1 || streamErr.Position != 3 {
+		t.Fatalf(
+			"expected the error at position 3 of the right input, got input %d, position %d (%v)",
+			streamErr.Input,
+			streamErr.Position,
+			err,
+		)
+	}
+}
+
+func TestStreamErrorThroughIterator(t *testing.T) {
+	cause := fmt.Errorf("cannot map 3")
+	s := stream.Map(
+		stream.Of(1, 2, 3, 4),
+		func(i int) int {
+			if i == 3 {
+				panic(cause)
+			}
+			return i
+		},
+	)
+	iterator := s.Iterator()
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	var result []int
+	err := lang.Safe(
+		func() {
+			iterator.ForEachRemaining(
+				func(i int) {
+					result = append(result, i)
+				},
+			)
+		},
+	)
+
+	var streamErr stream.StreamError
+	if !errors.As(err, &streamErr) {
+		t.Fatalf("expected a StreamError, got %v (elements: %v)", err, result)
+	}
+	if streamErr.Stage.Name != "Map" || streamErr.Position != 2 || !errors.Is(err, cause) {
+		t.Fatalf("expected the error at position 2 of Map, got %v", err)
+	}
+	if len(result) != 2 {
+		t.Fatalf("expected the 2 elements before the failure, got %v", result)
+	}
+	// The error is only reported once.
+	if iterator.HasNext() {
+		t.Fatalf("expected no more elements after the error")
+	}
+}
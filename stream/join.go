@@ -0,0 +1,180 @@
+package stream
+
+import (
+	"github.com/apitalist/collections"
+)
+
+// InnerJoin correlates the elements of two streams by a key. The right stream is read completely and hashed by the
+// rightKey function, then the left stream is processed element by element. For each left element the combiner is
+// called with every right element sharing the same key. Left elements without a matching right element are dropped.
+// The output follows the order of the left stream.
+func InnerJoin[TLeft, TRight any, TKey comparable, TOutput any](
+	left collections.Stream[TLeft],
+	right collections.Stream[TRight],
+	leftKey func(TLeft) TKey,
+	rightKey func(TRight) TKey,
+	combiner func(TLeft, TRight) TOutput,
+) collections.Stream[TOutput] {
+	return join(
+		"InnerJoin", left, right, leftKey, rightKey, func(l TLeft, r *TRight) (TOutput, bool) {
+			if r == nil {
+				var item TOutput
+				return item, false
+			}
+			return combiner(l, *r), true
+		},
+	)
+}
+
+// LeftJoin correlates the elements of two streams by a key, similar to InnerJoin. However, left elements without a
+// matching right element are not dropped, the combiner is called with a nil right element instead. The output follows
+// the order of the left stream.
+func LeftJoin[TLeft, TRight any, TKey comparable, TOutput any](
+	left collections.Stream[TLeft],
+	right collections.Stream[TRight],
+	leftKey func(TLeft) TKey,
+	rightKey func(TRight) TKey,
+	combiner func(TLeft, *TRight) TOutput,
+) collections.Stream[TOutput] {
+	return join(
+		"LeftJoin", left, right, leftKey, rightKey, func(l TLeft, r *TRight) (TOutput, bool) {
+			return combiner(l, r), true
+		},
+	)
+}
+
+// CoGroup groups the elements of both streams by their key and calls the combiner once for each distinct key with all
+// left and right elements sharing that key. One of the slices may be empty if the key is only present on one side.
+// Since all elements of a key must be known before calling the combiner, both streams are read completely before the
+// first element is passed downstream. The keys are processed in the order they first appear in the left stream, then
+// in the right stream.
+func CoGroup[TLeft, TRight any, TKey comparable, TOutput any](
+	left collections.Stream[TLeft],
+	right collections.Stream[TRight],
+	leftKey func(TLeft) TKey,
+	rightKey func(TRight) TKey,
+	combiner func(TKey, []TLeft, []TRight) TOutput,
+) collections.Stream[TOutput] {
+	leftIterator := left.Iterator()
+	rightIterator := right.Iterator()
+	st := newStage("CoGroup", stageOf(left), stageOf(right))
+	return newStream[TOutput](
+		st,
+		func(emit func(TOutput) bool) error {
+			var keys []TKey
+			seen := map[TKey]struct{}{}
+			onKey := func(key TKey) {
+				if _, ok := seen[key]; !ok {
+					seen[key] = struct{}{}
+					keys = append(keys, key)
+				}
+			}
+			leftGroups, err := groupByKey[TLeft](st, 0, leftIterator, leftKey, onKey)
+			if err != nil {
+				return err
+			}
+			rightGroups, err := groupByKey[TRight](st, 1, rightIterator, rightKey, onKey)
+			if err != nil {
+				return err
+			}
+			for _, key := range keys {
+				var item TOutput
+				st.call(
+					func() {
+						item = combiner(key, leftGroups[key], rightGroups[key])
+					},
+				)
+				if !emit(item) {
+					return nil
+				}
+			}
+			return nil
+		},
+		leftIterator,
+		rightIterator,
+	)
+}
+
+// join implements the hash join for InnerJoin and LeftJoin in a stage with the specified name. The combine function
+// is called for each left element with each matching right element, or with nil if there is no matching right element.
+// It returns false if the element should not be emitted.
+func join[TLeft, TRight any, TKey comparable, TOutput any](
+	name string,
+	left collections.Stream[TLeft],
+	right collections.Stream[TRight],
+	leftKey func(TLeft) TKey,
+	rightKey func(TRight) TKey,
+	combine func(l TLeft, r *TRight) (TOutput, bool),
+) collections.Stream[TOutput] {
+	leftIterator := left.Iterator()
+	rightIterator := right.Iterator()
+	st := newStage(name, stageOf(left), stageOf(right))
+	return newStream[TOutput](
+		st,
+		func(emit func(TOutput) bool) error {
+			rightGroups, err := groupByKey[TRight](st, 1, rightIterator, rightKey, func(TKey) {})
+			if err != nil {
+				return err
+			}
+			emitCombined := func(l TLeft, r *TRight) bool {
+				var item TOutput
+				var ok bool
+				st.call(
+					func() {
+						item, ok = combine(l, r)
+					},
+				)
+				return !ok || emit(item)
+			}
+			return consumeInput[TLeft](
+				st, 0, leftIterator, func(e TLeft) bool {
+					var key TKey
+					st.call(
+						func() {
+							key = leftKey(e)
+						},
+					)
+					matches := rightGroups[key]
+					if len(matches) == 0 {
+						return emitCombined(e, nil)
+					}
+					for i := range matches {
+						if !emitCombined(e, &matches[i]) {
+							return false
+						}
+					}
+					return true
+				},
+			)
+		},
+		leftIterator,
+		rightIterator,
+	)
+}
+
+// groupByKey reads all elements from the iterator, which is the specified input of the stage, and groups them by the
+// key returned from the key function. The onKey function is called with every key in the order they appear in the
+// iterator.
+func groupByKey[T any, TKey comparable](
+	st *stage,
+	input int,
+	iterator collections.Iterator[T],
+	key func(T) TKey,
+	onKey func(TKey),
+) (map[TKey][]T, error) {
+	groups := map[TKey][]T{}
+	err := consumeInput(
+		st, input, iterator, func(e T) bool {
+			var k TKey
+			st.call(
+				func() {
+					k = key(e)
+				},
+			)
+			onKey(k)
+			groups[k] = append(groups[k], e)
+			return true
+		},
+	)
+	return groups, err
+}
@@ -0,0 +1,88 @@
+package stream_test
+
+import (
+	"fmt"
+
+	"github.com/apitalist/collections/stream"
+)
+
+type user struct {
+	id   int
+	name string
+}
+
+type order struct {
+	userID  int
+	product string
+}
+
+func ExampleInnerJoin() {
+	users := stream.Of(user{1, "Alice"}, user{2, "Bob"}, user{3, "Carol"})
+	orders := stream.Of(order{1, "book"}, order{3, "pen"}, order{1, "lamp"})
+
+	result := stream.InnerJoin(
+		users,
+		orders,
+		func(u user) int { return u.id },
+		func(o order) int { return o.userID },
+		func(u user, o order) string {
+			return fmt.Sprintf("%s ordered a %s", u.name, o.product)
+		},
+	).ToSlice()
+
+	for _, r := range result {
+		fmt.Println(r)
+	}
+
+	// Output: Alice ordered a book
+	// Alice ordered a lamp
+	// Carol ordered a pen
+}
+
+func ExampleLeftJoin() {
+	users := stream.Of(user{1, "Alice"}, user{2, "Bob"})
+	orders := stream.Of(order{1, "book"})
+
+	result := stream.LeftJoin(
+		users,
+		orders,
+		func(u user) int { return u.id },
+		func(o order) int { return o.userID },
+		func(u user, o *order) string {
+			if o == nil {
+				return fmt.Sprintf("%s has no orders", u.name)
+			}
+			return fmt.Sprintf("%s ordered a %s", u.name, o.product)
+		},
+	).ToSlice()
+
+	for _, r := range result {
+		fmt.Println(r)
+	}
+
+	// Output: Alice ordered a book
+	// Bob has no orders
+}
+
+func ExampleCoGroup() {
+	users := stream.Of(user{1, "Alice"}, user{2, "Bob"})
+	orders := stream.Of(order{1, "book"}, order{1, "lamp"}, order{4, "pen"})
+
+	result := stream.CoGroup(
+		users,
+		orders,
+		func(u user) int { return u.id },
+		func(o order) int { return o.userID },
+		func(id int, u []user, o []order) string {
+			return fmt.Sprintf("%d: %d user(s), %d order(s)", id, len(u), len(o))
+		},
+	).ToSlice()
+
+	for _, r := range result {
+		fmt.Println(r)
+	}
+
+	// Output: 1: 1 user(s), 2 order(s)
+	// 2: 1 user(s), 0 order(s)
+	// 4: 0 user(s), 1 order(s)
+}
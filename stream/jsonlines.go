@@ -0,0 +1,69 @@
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/apitalist/collections"
+)
+
+// DecodeJSONLines creates a stream that lazily decodes newline-delimited JSON records from the reader using
+// encoding/json. Empty lines are skipped. If a record cannot be decoded, a DecodeError with the line number is passed
+// down the stream. If the reader implements io.Closer, it is closed when the stream is closed.
+func DecodeJSONLines[T any](r io.Reader) collections.Stream[T] {
+	s := newStream[T](
+		newStage("DecodeJSONLines"),
+		func(emit func(T) bool) error {
+			reader := bufio.NewReader(r)
+			line := uint(0)
+			for {
+				data, err := reader.ReadBytes('\n')
+				if len(data) > 0 {
+					line++
+				}
+				if len(bytes.TrimSpace(data)) > 0 {
+					var e T
+					if err := json.Unmarshal(data, &e); err != nil {
+						return DecodeError{
+							Line:  line,
+							Cause: err,
+						}
+					}
+					if !emit(e) {
+						return nil
+					}
+				}
+				if err != nil {
+					if errors.Is(err, io.EOF) {
+						return nil
+					}
+					return err
+				}
+			}
+		},
+	)
+	if closer, ok := r.(io.Closer); ok {
+		s.OnClose(
+			func() {
+				_ = closer.Close()
+			},
+		)
+	}
+	return s
+}
+
+// WriteJSONLines encodes the elements of the stream as newline-delimited JSON to the writer using encoding/json. It
+// returns the first error encountered, including errors passed from upstream.
+//
+// This is a terminal function for the stream.
+func WriteJSONLines[T any](w io.Writer, s collections.Stream[T]) error {
+	encoder := json.NewEncoder(w)
+	return tryForEach(
+		s, func(e T) error {
+			return encoder.Encode(e)
+		},
+	)
+}
@@ -0,0 +1,69 @@
+package stream_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/apitalist/collections/stream"
+	"github.com/apitalist/lang"
+)
+
+type logEntry struct {
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+func ExampleDecodeJSONLines() {
+	r := strings.NewReader(`{"level":"info","message":"Starting up"}
+{"level":"error","message":"Something went wrong"}
+{"level":"info","message":"Shutting down"}
+`)
+
+	failures := stream.
+		DecodeJSONLines[logEntry](r).
+		Filter(
+			func(e logEntry) bool {
+				return e.Level == "error"
+			},
+		).
+		ToSlice()
+	fmt.Println(failures)
+
+	// Output: [{error Something went wrong}]
+}
+
+func ExampleDecodeJSONLines_error() {
+	r := strings.NewReader(`{"level":"info","message":"Starting up"}
+{"level":"error",
+`)
+
+	err := lang.Safe(
+		func() {
+			stream.DecodeJSONLines[logEntry](r).ToSlice()
+		},
+	)
+
+	// The decode error contains the line number:
+	var decodeErr stream.DecodeError
+	if errors.As(err, &decodeErr) {
+		fmt.Printf("Invalid record in line %d.\n", decodeErr.Line)
+	}
+
+	// Output: Invalid record in line 2.
+}
+
+func ExampleWriteJSONLines() {
+	s := stream.Of(
+		logEntry{"info", "Starting up"},
+		logEntry{"info", "Shutting down"},
+	)
+
+	if err := stream.WriteJSONLines(os.Stdout, s); err != nil {
+		panic(err)
+	}
+
+	// Output: {"level":"info","message":"Starting up"}
+	// {"level":"info","message":"Shutting down"}
+}
@@ -0,0 +1,123 @@
+package stream_test
+
+import (
+	"runtime"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/apitalist/collections/slice"
+	"github.com/apitalist/collections/stream"
+)
+
+// assertGoroutinesReturnToBaseline runs the garbage collector until the number of goroutines drops back to the
+// baseline, or fails the test after a timeout.
+func assertGoroutinesReturnToBaseline(t *testing.T, baseline int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		runtime.GC()
+		current := runtime.NumGoroutine()
+		if current <= baseline {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("goroutines did not return to baseline (baseline: %d, current: %d)", baseline, current)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func abandonStreams() {
+	_ = stream.Of(1, 2, 3)
+	_ = stream.Of(1, 2, 3).Filter(func(i int) bool { return i%2 == 0 })
+	_ = stream.Of(1, 2, 3).Map(func(i int) int { return i * 2 })
+	_ = stream.Map(stream.Of(1, 2, 3), func(i int) string { return "" })
+	_ = stream.Scan(stream.Of(1, 2, 3), 0, func(a int, i int) int { return a + i })
+	_ = stream.FromCollection[int](slice.New(1, 2, 3))
+
+	iterator := stream.Of(1, 2, 3).Filter(func(i int) bool { return true }).Iterator()
+	iterator.Next()
+}
+
+func TestAbandonedStreamsDoNotLeak(t *testing.T) {
+	baseline := runtime.NumGoroutine()
+	abandonStreams()
+	assertGoroutinesReturnToBaseline(t, baseline)
+}
+
+func TestClosedStreamsDoNotLeak(t *testing.T) {
+	baseline := runtime.NumGoroutine()
+
+	s := stream.Map(stream.Of(1, 2, 3).Filter(func(i int) bool { return true }), func(i int) int { return i })
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	iterator := stream.Of(1, 2, 3).Map(func(i int) int { return i }).Iterator()
+	iterator.Next()
+	if err := iterator.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	assertGoroutinesReturnToBaseline(t, baseline)
+	runtime.KeepAlive(s)
+	runtime.KeepAlive(iterator)
+}
+
+func TestShortCircuitedStreamsDoNotLeak(t *testing.T) {
+	baseline := runtime.NumGoroutine()
+
+	if first := stream.Map(
+		stream.Of(1, 2, 3, 4).Filter(func(i int) bool { return i > 1 }),
+		func(i int) int { return i * 2 },
+	).FindFirst(); first != 4 {
+		t.Fatalf("unexpected first element: %d", first)
+	}
+	if !stream.Of(1, 2, 3, 4).AnyMatch(func(i int) bool { return i == 2 }) {
+		t.Fatalf("AnyMatch did not find the element")
+	}
+	if stream.Of(1, 2, 3, 4).AllMatch(func(i int) bool { return i == 1 }) {
+		t.Fatalf("AllMatch matched all elements")
+	}
+	joined := stream.InnerJoin(
+		stream.Of(1, 2, 3),
+		stream.Of(1, 2, 3),
+		func(i int) int { return i },
+		func(i int) int { return i },
+		func(a, b int) int { return a + b },
+	).FindFirst()
+	if joined != 2 {
+		t.Fatalf("unexpected joined element: %d", joined)
+	}
+	for i := range stream.FromSeq(slices.Values([]int{1, 2, 3, 4})).All() {
+		if i == 2 {
+			break
+		}
+	}
+
+	assertGoroutinesReturnToBaseline(t, baseline)
+}
+
+func TestFailedStreamsDoNotLeak(t *testing.T) {
+	baseline := runtime.NumGoroutine()
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatalf("no panic from failed mapper")
+			}
+		}()
+		stream.Map(
+			stream.Of(1, 2, 3, 4),
+			func(i int) int {
+				if i == 2 {
+					panic("failed")
+				}
+				return i
+			},
+		).ToSlice()
+	}()
+
+	assertGoroutinesReturnToBaseline(t, baseline)
+}
@@ -0,0 +1,46 @@
+package stream
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/apitalist/collections"
+)
+
+// Lines creates a stream of the lines read from the reader, without the line endings. Read errors are passed down the
+// stream. If the reader implements io.Closer, it is closed when the stream is closed.
+func Lines(r io.Reader) collections.Stream[string] {
+	return splitReader("Lines", r, bufio.ScanLines)
+}
+
+// Split creates a stream of the tokens read from the reader using a bufio.Scanner with the specified split function,
+// e.g. bufio.ScanWords. Read errors are passed down the stream. If the reader implements io.Closer, it is closed when
+// the stream is closed.
+func Split(r io.Reader, split bufio.SplitFunc) collections.Stream[string] {
+	return splitReader("Split", r, split)
+}
+
+// splitReader implements Lines and Split, creating a stage with the specified name.
+func splitReader(name string, r io.Reader, split bufio.SplitFunc) collections.Stream[string] {
+	s := newStream[string](
+		newStage(name),
+		func(emit func(string) bool) error {
+			scanner := bufio.NewScanner(r)
+			scanner.Split(split)
+			for scanner.Scan() {
+				if !emit(scanner.Text()) {
+					return nil
+				}
+			}
+			return scanner.Err()
+		},
+	)
+	if closer, ok := r.(io.Closer); ok {
+		s.OnClose(
+			func() {
+				_ = closer.Close()
+			},
+		)
+	}
+	return s
+}
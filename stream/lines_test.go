@@ -0,0 +1,52 @@
+package stream_test
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/apitalist/collections/stream"
+)
+
+func ExampleLines() {
+	r := strings.NewReader("first line\nsecond line\r\nthird line")
+
+	lines := stream.Lines(r).ToSlice()
+	for _, line := range lines {
+		fmt.Println(line)
+	}
+
+	// Output: first line
+	// second line
+	// third line
+}
+
+type exampleFile struct {
+	io.Reader
+}
+
+func (e exampleFile) Close() error {
+	fmt.Println("File closed.")
+	return nil
+}
+
+func ExampleLines_closer() {
+	// Readers implementing io.Closer, such as files, are closed when the stream is closed:
+	f := exampleFile{strings.NewReader("a\nb\nc")}
+
+	first := stream.Lines(f).FindFirst()
+	fmt.Println(first)
+
+	// Output: File closed.
+	// a
+}
+
+func ExampleSplit() {
+	r := strings.NewReader("The quick brown fox")
+
+	words := stream.Split(r, bufio.ScanWords).Count()
+	fmt.Println(words)
+
+	// Output: 4
+}
@@ -0,0 +1,124 @@
+package stream
+
+import (
+	"container/heap"
+	"io"
+
+	"github.com/apitalist/collections"
+)
+
+// MergeSorted lazily merges any number of streams that are already sorted according to the comparator into a single
+// sorted stream. Only the current head element of each input stream is held in memory. If two elements are equal, the
+// element from the stream passed earlier is emitted first.
+func MergeSorted[T any](
+	comparator collections.Comparator[T],
+	streams ...collections.Stream[T],
+) collections.Stream[T] {
+	iterators := make([]collections.IteratorCloser[T], len(streams))
+	closers := make([]io.Closer, len(streams))
+	upstream := make([]*stage, len(streams))
+	for i, s := range streams {
+		iterators[i] = s.Iterator()
+		closers[i] = iterators[i]
+		upstream[i] = stageOf(s)
+	}
+	st := newStage("MergeSorted", upstream...)
+	return newStream[T](
+		st,
+		func(emit func(T) bool) error {
+			return mergeIterators(st, comparator, iterators, emit)
+		},
+		closers...,
+	)
+}
+
+// mergeIterators merges the elements of the already sorted iterators and passes them to the emit function in sorted
+// order. If emit returns false, the merge is stopped. The received elements and comparator calls are reported to the
+// passed stage.
+func mergeIterators[T any](
+	st *stage,
+	comparator collections.Comparator[T],
+	iterators []collections.IteratorCloser[T],
+	emit func(T) bool,
+) error {
+	h := &mergeHeap[T]{
+		comparator: observedComparator(st, comparator),
+	}
+	for i, iterator := range iterators {
+		item, ok, err := nextMergeItem(st, iterator, i)
+		if err != nil {
+			return err
+		}
+		if ok {
+			h.items = append(h.items, item)
+		}
+	}
+	heap.Init(h)
+	for h.Len() > 0 {
+		current := h.items[0]
+		if !emit(current.value) {
+			return nil
+		}
+		item, ok, err := nextMergeItem(st, iterators[current.source], current.source)
+		if err != nil {
+			return err
+		}
+		if ok {
+			h.items[0] = item
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return nil
+}
+
+// nextMergeItem fetches the next element from the iterator. It returns false if the iterator has no more elements.
+func nextMergeItem[T any](st *stage, iterator collections.IteratorCloser[T], source int) (mergeItem[T], bool, error) {
+	value, ok, err := next[T](iterator)
+	if ok {
+		st.received(source)
+	}
+	return mergeItem[T]{
+		value:  value,
+		source: source,
+	}, ok, err
+}
+
+// mergeItem is the head element of one of the merged iterators.
+type mergeItem[T any] struct {
+	value  T
+	source int
+}
+
+// mergeHeap is a min-heap of the current head elements of all merged iterators, implementing heap.Interface.
+type mergeHeap[T any] struct {
+	items      []mergeItem[T]
+	comparator collections.Comparator[T]
+}
+
+func (m *mergeHeap[T]) Len() int {
+	return len(m.items)
+}
+
+func (m *mergeHeap[T]) Less(i, j int) bool {
+	result := m.comparator(m.items[i].value, m.items[j].value)
+	if result == 0 {
+		return m.items[i].source < m.items[j].source
+	}
+	return result < 0
+}
+
+func (m *mergeHeap[T]) Swap(i, j int) {
+	m.items[i], m.items[j] = m.items[j], m.items[i]
+}
+
+func (m *mergeHeap[T]) Push(x any) {
+	m.items = append(m.items, x.(mergeItem[T]))
+}
+
+func (m *mergeHeap[T]) Pop() any {
+	item := m.items[len(m.items)-1]
+	m.items = m.items[:len(m.items)-1]
+	return item
+}
@@ -0,0 +1,93 @@
+package stream_test
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/apitalist/collections/stream"
+	"github.com/apitalist/lang"
+)
+
+func ExampleMergeSorted() {
+	// Each input stream must already be sorted:
+	shard1 := stream.Of(1, 4, 7)
+	shard2 := stream.Of(2, 5, 8, 9)
+	shard3 := stream.Of(3, 6)
+
+	result := stream.MergeSorted(
+		func(a, b int) int {
+			return a - b
+		},
+		shard1,
+		shard2,
+		shard3,
+	).ToSlice()
+	fmt.Println(result)
+
+	// Output: [1 2 3 4 5 6 7 8 9]
+}
+
+type mergeRecord struct {
+	key    int
+	source string
+}
+
+func compareMergeRecords(a, b mergeRecord) int {
+	return a.key - b.key
+}
+
+func TestMergeSortedIsStable(t *testing.T) {
+	result := stream.MergeSorted(
+		compareMergeRecords,
+		stream.Of(mergeRecord{1, "a"}, mergeRecord{2, "a1"}, mergeRecord{2, "a2"}, mergeRecord{3, "a"}),
+		stream.Of(mergeRecord{2, "b1"}, mergeRecord{2, "b2"}),
+		stream.Of(mergeRecord{0, "c"}, mergeRecord{2, "c"}),
+	).ToSlice()
+
+	expected := "[{0 c} {1 a} {2 a1} {2 a2} {2 b1} {2 b2} {2 c} {3 a}]"
+	if fmt.Sprint(result) != expected {
+		t.Fatalf("expected equal elements in input order %s, got %v", expected, result)
+	}
+}
+
+func TestMergeSortedEmptyInputs(t *testing.T) {
+	if result := stream.MergeSorted[int](cmp.Compare[int]).ToSlice(); len(result) != 0 {
+		t.Fatalf("expected an empty stream without inputs, got %v", result)
+	}
+	if result := stream.MergeSorted(cmp.Compare[int], stream.Of[int](), stream.Of[int]()).ToSlice(); len(result) != 0 {
+		t.Fatalf("expected an empty stream for empty inputs, got %v", result)
+	}
+	result := stream.MergeSorted(cmp.Compare[int], stream.Of[int](), stream.Of(1, 2), stream.Of[int]()).ToSlice()
+	if fmt.Sprint(result) != "[1 2]" {
+		t.Fatalf("expected the elements of the non-empty input, got %v", result)
+	}
+}
+
+func TestMergeSortedUpstreamError(t *testing.T) {
+	cause := fmt.Errorf("invalid element")
+	failing := stream.Map(
+		stream.Of(2, 4, 6),
+		func(i int) int {
+			if i == 4 {
+				panic(cause)
+			}
+			return i
+		},
+	)
+
+	err := lang.Safe(
+		func() {
+			stream.MergeSorted(cmp.Compare[int], stream.Of(1, 3, 5), failing).ToSlice()
+		},
+	)
+
+	var streamErr stream.StreamError
+	if !errors.As(err, &streamErr) {
+		t.Fatalf("expected a StreamError, got %v", err)
+	}
+	if streamErr.Stage.Name != "Map" || streamErr.Position != 1 || !errors.Is(err, cause) {
+		t.Fatalf("expected the error at position 1 of Map, got %v", err)
+	}
+}
@@ -0,0 +1,146 @@
+package stream
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/apitalist/collections"
+)
+
+// Stage identifies a single processing step of a stream pipeline, such as a Filter or Map.
+type Stage struct {
+	// Index is the position of the stage in the pipeline. Sources have the index 0, each following stage has an index
+	// one larger than its upstream stage.
+	Index uint
+	// Name is the name of the function that created the stage, e.g. Filter.
+	Name string
+}
+
+// String returns the index and name of the stage.
+func (s Stage) String() string {
+	return fmt.Sprintf("#%d %s", s.Index, s.Name)
+}
+
+// Observer receives events about the processing of the individual stages of a stream pipeline. This can be used to
+// find slow stages or to trace the stream processing. Use Observe to attach an observer to a stream. Since the stages
+// run in separate goroutines, the functions of the observer may be called concurrently.
+type Observer interface {
+	// ElementReceived is called when a stage receives an element from its upstream stage.
+	ElementReceived(stage Stage)
+	// ElementEmitted is called when a stage passes an element to its downstream stage.
+	ElementEmitted(stage Stage)
+	// FunctionCalled is called after a stage called a user-supplied function, such as a predicate or a mapper, with
+	// the time spent in the function.
+	FunctionCalled(stage Stage, duration time.Duration)
+	// Failed is called when the processing of a stage fails. The error is a StreamError wrapping the original cause.
+	Failed(stage Stage, err error)
+	// Finished is called once when the last stream in the pipeline is closed, for example after the terminal element
+	// completed.
+	Finished()
+}
+
+// Observe attaches the observer to the pipeline of the passed stream. The observer receives the events of all stages
+// of the pipeline, including upstream stages and stages added later. The observer should be attached before calling
+// the terminal element, otherwise some events may be missed. It returns the passed stream.
+func Observe[T any](s collections.Stream[T], observer Observer) collections.Stream[T] {
+	if st := stageOf(s); st != nil {
+		st.pipeline.setObserver(observer)
+	}
+	return s
+}
+
+// NewSummaryObserver creates an observer that collects the element counts, time spent in user-supplied functions and
+// errors of each stage, then writes a human-readable summary table to the writer when the pipeline is finished.
+func NewSummaryObserver(w io.Writer) Observer {
+	return &summaryObserver{
+		w:      w,
+		lock:   &sync.Mutex{},
+		stages: map[Stage]*stageSummary{},
+	}
+}
+
+type stageSummary struct {
+	received uint
+	emitted  uint
+	calls    uint
+	duration time.Duration
+	errors   uint
+}
+
+type summaryObserver struct {
+	w      io.Writer
+	lock   *sync.Mutex
+	stages map[Stage]*stageSummary
+}
+
+func (s *summaryObserver) get(stage Stage) *stageSummary {
+	summary, ok := s.stages[stage]
+	if !ok {
+		summary = &stageSummary{}
+		s.stages[stage] = summary
+	}
+	return summary
+}
+
+func (s *summaryObserver) ElementReceived(stage Stage) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.get(stage).received++
+}
+
+func (s *summaryObserver) ElementEmitted(stage Stage) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.get(stage).emitted++
+}
+
+func (s *summaryObserver) FunctionCalled(stage Stage, duration time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	summary := s.get(stage)
+	summary.calls++
+	summary.duration += duration
+}
+
+func (s *summaryObserver) Failed(stage Stage, _ error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.get(stage).errors++
+}
+
+func (s *summaryObserver) Finished() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	stages := make([]Stage, 0, len(s.stages))
+	for stage := range s.stages {
+		stages = append(stages, stage)
+	}
+	sort.SliceStable(
+		stages, func(i, j int) bool {
+			if stages[i].Index != stages[j].Index {
+				return stages[i].Index < stages[j].Index
+			}
+			return stages[i].Name < stages[j].Name
+		},
+	)
+	w := tabwriter.NewWriter(s.w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	_, _ = fmt.Fprintln(w, "Stage\tIn\tOut\tCalls\tTime\tErrors\t")
+	for _, stage := range stages {
+		summary := s.stages[stage]
+		_, _ = fmt.Fprintf(
+			w,
+			"%s\t%d\t%d\t%d\t%s\t%d\t\n",
+			stage,
+			summary.received,
+			summary.emitted,
+			summary.calls,
+			summary.duration,
+			summary.errors,
+		)
+	}
+	_ = w.Flush()
+}
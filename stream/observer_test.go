@@ -0,0 +1,133 @@
+package stream_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apitalist/collections/stream"
+)
+
+// countingObserver is an example observer that counts the elements passing each stage. Stages run on separate
+// goroutines, so observers must be safe for concurrent use.
+type countingObserver struct {
+	lock     sync.Mutex
+	received map[stream.Stage]int
+	emitted  map[stream.Stage]int
+}
+
+func (c *countingObserver) ElementReceived(stage stream.Stage) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.received[stage]++
+}
+
+func (c *countingObserver) ElementEmitted(stage stream.Stage) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.emitted[stage]++
+}
+
+func (c *countingObserver) FunctionCalled(_ stream.Stage, _ time.Duration) {
+}
+
+func (c *countingObserver) Failed(stage stream.Stage, err error) {
+	fmt.Printf("%s failed: %v\n", stage, err)
+}
+
+func (c *countingObserver) Finished() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stages := make([]stream.Stage, 0, len(c.emitted))
+	for stage := range c.emitted {
+		stages = append(stages, stage)
+	}
+	sort.Slice(stages, func(i, j int) bool {
+		return stages[i].Index < stages[j].Index
+	})
+	for _, stage := range stages {
+		fmt.Printf("%s: %d in, %d out\n", stage, c.received[stage], c.emitted[stage])
+	}
+}
+
+func ExampleObserve() {
+	observer := &countingObserver{
+		received: map[stream.Stage]int{},
+		emitted:  map[stream.Stage]int{},
+	}
+
+	s := stream.Map(
+		stream.Observe(
+			stream.Of(1, 2, 3, 4, 5, 6).Filter(
+				func(i int) bool {
+					return i%2 == 0
+				},
+			),
+			observer,
+		),
+		func(i int) string {
+			return fmt.Sprintf("%d", i)
+		},
+	)
+	s.ToSlice()
+
+	// Output: #0 Of: 0 in, 6 out
+	// #1 Filter: 6 in, 3 out
+	// #2 Map: 3 in, 3 out
+}
+
+func ExampleNewSummaryObserver() {
+	s := stream.Map(
+		stream.Of(1, 2, 3, 4, 5, 6).Filter(
+			func(i int) bool {
+				return i%2 == 0
+			},
+		),
+		func(i int) string {
+			return fmt.Sprintf("%d", i)
+		},
+	)
+
+	// The summary is written after the terminal element completes.
+	stream.Observe(s, stream.NewSummaryObserver(os.Stdout)).ToSlice()
+}
+
+func TestSummaryObserver(t *testing.T) {
+	buf := &bytes.Buffer{}
+	s := stream.Map(
+		stream.Of(1, 2, 3, 4, 5, 6).Filter(
+			func(i int) bool {
+				return i%2 == 0
+			},
+		),
+		func(i int) int {
+			if i == 6 {
+				panic(fmt.Errorf("cannot process 6"))
+			}
+			return i
+		},
+	)
+	func() {
+		defer func() {
+			_ = recover()
+		}()
+		stream.Observe(s, stream.NewSummaryObserver(buf)).ToSlice()
+	}()
+
+	expected := []*regexp.Regexp{
+		regexp.MustCompile(`Stage\s+In\s+Out\s+Calls\s+Time\s+Errors`),
+		regexp.MustCompile(`#0 Of\s+0\s+6\s+0\s+0s\s+0`),
+		regexp.MustCompile(`#1 Filter\s+6\s+3\s+6\s+\S+\s+0`),
+		regexp.MustCompile(`#2 Map\s+3\s+2\s+3\s+\S+\s+1`),
+	}
+	for _, e := range expected {
+		if !e.Match(buf.Bytes()) {
+			t.Fatalf("summary does not match %s:\n%s", e, buf.String())
+		}
+	}
+}
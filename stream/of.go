@@ -2,27 +2,17 @@ package stream
 
 import "github.com/apitalist/collections"
 
+// Of creates a stream from the passed elements.
 func Of[E any](elements ...E) collections.Stream[E] {
-	input := make(chan E)
-	complete := make(chan struct{})
-	errorInput := make(chan error)
-	s := &stream[E]{
-		input:      input,
-		complete:   complete,
-		errorInput: errorInput,
-	}
-	go func() {
-		defer func() {
-			close(input)
-			close(errorInput)
-		}()
-		for _, e := range elements {
-			select {
-			case input <- e:
-			case <-complete:
-				return
+	return newStream[E](
+		newStage("Of"),
+		func(emit func(E) bool) error {
+			for _, e := range elements {
+				if !emit(e) {
+					return nil
+				}
 			}
-		}
-	}()
-	return s
+			return nil
+		},
+	)
 }
@@ -0,0 +1,83 @@
+package stream_test
+
+import (
+	"fmt"
+
+	"github.com/apitalist/collections/stream"
+	"github.com/apitalist/lang"
+)
+
+func Example_onClose() {
+	s := stream.
+		Of(1, 2, 3, 4).
+		OnClose(
+			func() {
+				fmt.Println("Closing source...")
+			},
+		).
+		Filter(
+			func(i int) bool {
+				return i%2 == 0
+			},
+		).
+		OnClose(
+			func() {
+				fmt.Println("Closing filter...")
+			},
+		)
+
+	// The handlers run when the terminal element completes, upstream handlers first:
+	fmt.Println(s.ToSlice())
+
+	// Output: Closing source...
+	// Closing filter...
+	// [2 4]
+}
+
+func Example_onCloseError() {
+	s := stream.Map(
+		stream.
+			Of(1, 2, 3, 4).
+			OnClose(
+				func() {
+					fmt.Println("Closing source...")
+				},
+			),
+		func(i int) int {
+			if i == 3 {
+				panic(fmt.Errorf("cannot process 3"))
+			}
+			return i
+		},
+	)
+
+	// Handlers also run if the stream processing fails:
+	err := lang.Safe(
+		func() {
+			s.ToSlice()
+		},
+	)
+	fmt.Println(err)
+
+	// Output: Closing source...
+	// stream stage #1 Map failed at element 2 (cannot process 3)
+}
+
+func Example_onCloseIterator() {
+	iterator := stream.
+		Of(1, 2, 3, 4).
+		OnClose(
+			func() {
+				fmt.Println("Closing source...")
+			},
+		).
+		Iterator()
+
+	fmt.Println(iterator.Next())
+
+	// Closing the iterator runs the handlers:
+	_ = iterator.Close()
+
+	// Output: 1
+	// Closing source...
+}
@@ -0,0 +1,32 @@
+package stream
+
+import (
+	"math/rand"
+
+	"github.com/apitalist/collections"
+)
+
+// Sample returns k randomly selected elements from the stream using reservoir sampling. Each element has the same
+// probability of being selected, and only k elements are held in memory regardless of the size of the stream. If the
+// stream has fewer than k elements, all elements are returned. The random numbers are taken from the passed source,
+// which lets you create reproducible samples.
+//
+// This is a terminal function for the stream.
+func Sample[T any](s collections.Stream[T], k uint, source rand.Source) []T {
+	random := rand.New(source)
+	result := make([]T, 0, k)
+	seen := int64(0)
+	forEach(
+		s, func(e T) {
+			seen++
+			if uint(len(result)) < k {
+				result = append(result, e)
+				return
+			}
+			if j := random.Int63n(seen); j < int64(k) {
+				result[j] = e
+			}
+		},
+	)
+	return result
+}
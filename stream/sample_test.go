@@ -0,0 +1,70 @@
+package stream_test
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/apitalist/collections/stream"
+)
+
+func ExampleSample() {
+	// Use a fixed seed to get a reproducible sample:
+	sample := stream.Sample(
+		stream.Of(1, 2, 3, 4, 5, 6, 7, 8, 9, 10),
+		3,
+		rand.NewSource(42),
+	)
+	fmt.Println(len(sample))
+
+	// If the stream is shorter than the sample size, all elements are returned:
+	sample = stream.Sample(stream.Of(1, 2), 3, rand.NewSource(42))
+	fmt.Println(sample)
+
+	// Output: 3
+	// [1 2]
+}
+
+func TestSampleIsReproducible(t *testing.T) {
+	input := make([]int, 1000)
+	for i := range input {
+		input[i] = i
+	}
+
+	first := stream.Sample(stream.Of(input...), 10, rand.NewSource(42))
+	second := stream.Sample(stream.Of(input...), 10, rand.NewSource(42))
+	if !reflect.DeepEqual(first, second) {
+		t.Fatalf("samples with the same seed differ: %v and %v", first, second)
+	}
+
+	seen := map[int]bool{}
+	for _, e := range first {
+		if e < 0 || e >= len(input) {
+			t.Fatalf("sample contains an element not in the input: %d", e)
+		}
+		if seen[e] {
+			t.Fatalf("sample contains element %d twice: %v", e, first)
+		}
+		seen[e] = true
+	}
+	if reflect.DeepEqual(first, input[:10]) {
+		t.Fatalf("sample only contains the first elements, no element has been replaced: %v", first)
+	}
+}
+
+func TestSampleSelectsAllElements(t *testing.T) {
+	// Every element must have a chance to be selected, including those after the reservoir has been filled.
+	selected := map[int]int{}
+	for seed := int64(0); seed < 1000; seed++ {
+		for _, e := range stream.Sample(stream.Of(0, 1, 2, 3, 4, 5, 6, 7, 8, 9), 3, rand.NewSource(seed)) {
+			selected[e]++
+		}
+	}
+	for e := 0; e < 10; e++ {
+		// Each element is expected to be selected 300 times.
+		if selected[e] < 200 || selected[e] > 400 {
+			t.Fatalf("element %d has been selected %d times out of the expected 300", e, selected[e])
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package stream
+
+import (
+	"github.com/apitalist/collections"
+)
+
+// Scan takes an input stream, an initial accumulator value and an accumulator function, then creates an output stream
+// containing the accumulator value after each input element. This is useful for running totals and similar cumulative
+// values. The initial value itself is not emitted.
+func Scan[TInput, TAccumulator any](
+	input collections.Stream[TInput],
+	initial TAccumulator,
+	accumulator func(TAccumulator, TInput) TAccumulator,
+) collections.Stream[TAccumulator] {
+	iterator := input.Iterator()
+	st := newStage("Scan", stageOf(input))
+	return newStream[TAccumulator](
+		st,
+		func(emit func(TAccumulator) bool) error {
+			current := initial
+			return consume[TInput](
+				st, iterator, func(e TInput) bool {
+					st.call(
+						func() {
+							current = accumulator(current, e)
+						},
+					)
+					return emit(current)
+				},
+			)
+		},
+		iterator,
+	)
+}
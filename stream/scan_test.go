@@ -0,0 +1,113 @@
+package stream_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/apitalist/collections/stream"
+	"github.com/apitalist/lang"
+)
+
+func ExampleScan() {
+	// Calculate a running total of the stream elements:
+	s := stream.Scan(
+		stream.Of(1, 2, 3, 4, 5),
+		0,
+		func(total int, e int) int {
+			return total + e
+		},
+	).ToSlice()
+	fmt.Println(s)
+
+	// Output: [1 3 6 10 15]
+}
+
+func ExampleScan_typeConversion() {
+	// The accumulator may have a different type than the stream elements:
+	s := stream.Scan(
+		stream.Of("a", "b", "c"),
+		"",
+		func(prefix string, e string) string {
+			return prefix + e
+		},
+	).ToSlice()
+	fmt.Println(s)
+
+	// Output: [a ab abc]
+}
+
+func TestScanAccumulatorError(t *testing.T) {
+	cause := fmt.Errorf("negative element")
+	s := stream.Scan(
+		stream.Of(1, 2, -3, 4),
+		0,
+		func(total int, e int) int {
+			if e < 0 {
+				panic(cause)
+			}
+			return total + e
+		},
+	)
+
+	var result []int
+	err := lang.Safe(
+		func() {
+			for total := range s.All() {
+				result = append(result, total)
+			}
+		},
+	)
+
+	var streamErr stream.StreamError
+	if !errors.As(err, &streamErr) {
+		t.Fatalf("expected a StreamError, got %v", err)
+	}
+	if streamErr.Stage.Name != "Scan" || streamErr.Stage.Index != 1 || streamErr.Position != 2 {
+		t.Fatalf("expected the error at position 2 of #1 Scan, got %v", err)
+	}
+	if !errors.Is(err, cause) {
+		t.Fatalf("expected the error to wrap the cause, got %v", err)
+	}
+	if fmt.Sprint(result) != "[1 3]" {
+		t.Fatalf("expected the running totals before the failure, got %v", result)
+	}
+}
+
+func TestScanUpstreamError(t *testing.T) {
+	s := stream.Scan(
+		stream.Map(
+			stream.Of("1", "2", "three"),
+			func(s string) int {
+				i, err := strconv.Atoi(s)
+				if err != nil {
+					panic(err)
+				}
+				return i
+			},
+		),
+		0,
+		func(total int, e int) int {
+			return total + e
+		},
+	)
+
+	err := lang.Safe(
+		func() {
+			s.ToSlice()
+		},
+	)
+
+	var streamErr stream.StreamError
+	if !errors.As(err, &streamErr) {
+		t.Fatalf("expected a StreamError, got %v", err)
+	}
+	// The error is reported by the failing stage and passed through Scan unchanged.
+	if streamErr.Stage.Name != "Map" || streamErr.Stage.Index != 1 || streamErr.Position != 2 {
+		t.Fatalf("expected the error at position 2 of #1 Map, got %v", err)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Fatalf("expected the error to wrap the cause, got %v", err)
+	}
+}
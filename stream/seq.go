@@ -0,0 +1,32 @@
+package stream
+
+import (
+	"iter"
+
+	"github.com/apitalist/collections"
+)
+
+// FromSeq creates a stream from the elements produced by the passed iterator function. The iterator function is run in
+// a separate goroutine and is stopped when the stream is closed.
+func FromSeq[T any](seq iter.Seq[T]) collections.Stream[T] {
+	return newStream[T](
+		newStage("FromSeq"),
+		func(emit func(T) bool) error {
+			for e := range seq {
+				if !emit(e) {
+					return nil
+				}
+			}
+			return nil
+		},
+	)
+}
+
+// ToSeq returns an iterator function over the elements of the passed stream for use in a for-range loop. The stream
+// is closed when the loop ends, including when the loop is stopped early. Errors passed from upstream are thrown in a
+// panic inside the loop.
+//
+// This is a terminal function for the stream.
+func ToSeq[T any](s collections.Stream[T]) iter.Seq[T] {
+	return s.All()
+}
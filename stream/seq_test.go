@@ -0,0 +1,42 @@
+package stream_test
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/apitalist/collections/stream"
+)
+
+func ExampleFromSeq() {
+	ages := map[string]int{
+		"Alice": 32,
+		"Bob":   17,
+		"Carol": 45,
+	}
+
+	adults := stream.FromSeq(maps.Keys(ages)).Filter(
+		func(name string) bool {
+			return ages[name] >= 18
+		},
+	)
+	fmt.Println(slices.Sorted(stream.ToSeq(adults)))
+
+	// Output: [Alice Carol]
+}
+
+func ExampleToSeq() {
+	s := stream.Of(1, 2, 3, 4, 5, 6)
+
+	// The stream is closed when the loop ends early:
+	for i := range stream.ToSeq(s) {
+		if i > 3 {
+			break
+		}
+		fmt.Println(i)
+	}
+
+	// Output: 1
+	// 2
+	// 3
+}
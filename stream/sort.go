@@ -0,0 +1,277 @@
+package stream
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/apitalist/collections"
+)
+
+// defaultMaxElementsInMemory is the number of elements ExternalSort holds in memory if no limit is configured.
+const defaultMaxElementsInMemory = 100000
+
+// ExternalSortConfig holds the settings for ExternalSort. The zero value is usable and results in the default
+// settings.
+type ExternalSortConfig[T any] struct {
+	// MaxElementsInMemory is the maximum number of elements held in memory. When this many elements are buffered,
+	// they are sorted and written to a temporary file as a sorted run. Defaults to 100000 if MaxBytesInMemory is not
+	// set, otherwise the number of elements is not limited.
+	MaxElementsInMemory uint
+	// MaxBytesInMemory is the maximum number of bytes the buffered elements may occupy, as determined by Size. When
+	// the buffered elements reach this size, they are written to a temporary file as a sorted run. Use this instead of
+	// MaxElementsInMemory if the size of the elements varies. If both limits are set, a run is written as soon as
+	// either of them is reached.
+	MaxBytesInMemory uint
+	// Size returns the number of bytes an element occupies in memory. It is only used if MaxBytesInMemory is set.
+	// Defaults to the encoded size of the element using the Codec, which is only an approximation of the size in
+	// memory and requires encoding each element twice.
+	Size func(T) uint
+	// TempDir is the directory the sorted runs are written to. Defaults to os.TempDir().
+	TempDir string
+	// Codec is used to write elements to the temporary files and read them back. Defaults to GobCodec.
+	Codec Codec[T]
+}
+
+// ExternalSort sorts a stream that may be too large to fit in memory. The elements are read in chunks limited by
+// MaxElementsInMemory and MaxBytesInMemory, each chunk is sorted and written to a temporary file. When the input is exhausted, the sorted
+// files are merged back into the output stream. If the input fits into a single chunk, no temporary files are
+// written. The temporary files are removed once the output stream is finished.
+//
+// The sort is stable, equal elements retain their order from the input stream.
+func ExternalSort[T any](
+	input collections.Stream[T],
+	comparator collections.Comparator[T],
+	config ExternalSortConfig[T],
+) collections.Stream[T] {
+	if config.MaxElementsInMemory == 0 && config.MaxBytesInMemory == 0 {
+		config.MaxElementsInMemory = defaultMaxElementsInMemory
+	}
+	if config.Codec == nil {
+		config.Codec = GobCodec[T]()
+	}
+	if config.MaxBytesInMemory > 0 && config.Size == nil {
+		config.Size = encodedSize(config.Codec)
+	}
+	iterator := input.Iterator()
+	st := newStage("ExternalSort", stageOf(input))
+	comparator = observedComparator(st, comparator)
+	return newStream[T](
+		st,
+		func(emit func(T) bool) error {
+			var runs []string
+			defer func() {
+				for _, run := range runs {
+					_ = os.Remove(run)
+				}
+			}()
+			var buffer []T
+			var bufferBytes uint
+			var runErr error
+			if err := consume[T](
+				st, iterator, func(e T) bool {
+					buffer = append(buffer, e)
+					if config.MaxBytesInMemory > 0 {
+						var size uint
+						st.call(
+							func() {
+								size = config.Size(e)
+							},
+						)
+						bufferBytes += size
+					}
+					if !bufferFull(config, uint(len(buffer)), bufferBytes) {
+						return true
+					}
+					var run string
+					run, runErr = writeSortedRun(buffer, comparator, config)
+					if run != "" {
+						runs = append(runs, run)
+					}
+					buffer = buffer[:0]
+					bufferBytes = 0
+					return runErr == nil
+				},
+			); err != nil {
+				return err
+			}
+			if runErr != nil {
+				return runErr
+			}
+			if len(runs) == 0 {
+				sortElements(buffer, comparator)
+				for _, e := range buffer {
+					if !emit(e) {
+						return nil
+					}
+				}
+				return nil
+			}
+			if len(buffer) > 0 {
+				run, err := writeSortedRun(buffer, comparator, config)
+				if run != "" {
+					runs = append(runs, run)
+				}
+				if err != nil {
+					return err
+				}
+			}
+			buffer = nil
+			return mergeSortedRuns(runs, comparator, config.Codec, emit)
+		},
+		iterator,
+	)
+}
+
+// bufferFull returns true if the buffered elements reached one of the memory limits of the configuration.
+func bufferFull[T any](config ExternalSortConfig[T], elements uint, bytes uint) bool {
+	if config.MaxElementsInMemory > 0 && elements >= config.MaxElementsInMemory {
+		return true
+	}
+	return config.MaxBytesInMemory > 0 && bytes >= config.MaxBytesInMemory
+}
+
+// encodedSize returns a size function that encodes the element using the codec and returns the number of bytes
+// written. If the element cannot be encoded, the error is thrown in a panic.
+func encodedSize[T any](codec Codec[T]) func(T) uint {
+	counter := &countingWriter{}
+	encoder := codec.NewEncoder(counter)
+	return func(e T) uint {
+		before := counter.count
+		if err := encoder.Encode(e); err != nil {
+			panic(fmt.Errorf("failed to encode element to determine its size (%w)", err))
+		}
+		return counter.count - before
+	}
+}
+
+// countingWriter discards the written data and only counts the number of bytes.
+type countingWriter struct {
+	count uint
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	c.count += uint(len(p))
+	return len(p), nil
+}
+
+// sortElements sorts the passed slice in place using the comparator.
+func sortElements[T any](elements []T, comparator collections.Comparator[T]) {
+	sort.SliceStable(
+		elements, func(i, j int) bool {
+			return comparator(elements[i], elements[j]) < 0
+		},
+	)
+}
+
+// writeSortedRun sorts the elements and writes them to a new temporary file. It returns the name of the file, even
+// if an error happened after the file was created, so it can be cleaned up.
+func writeSortedRun[T any](
+	elements []T,
+	comparator collections.Comparator[T],
+	config ExternalSortConfig[T],
+) (string, error) {
+	sortElements(elements, comparator)
+	fh, err := os.CreateTemp(config.TempDir, "stream-sort-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary file for sorting (%w)", err)
+	}
+	writer := bufio.NewWriter(fh)
+	encoder := config.Codec.NewEncoder(writer)
+	for _, e := range elements {
+		if err := encoder.Encode(e); err != nil {
+			_ = fh.Close()
+			return fh.Name(), fmt.Errorf("failed to encode element to %s (%w)", fh.Name(), err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		_ = fh.Close()
+		return fh.Name(), fmt.Errorf("failed to write %s (%w)", fh.Name(), err)
+	}
+	if err := fh.Close(); err != nil {
+		return fh.Name(), fmt.Errorf("failed to close %s (%w)", fh.Name(), err)
+	}
+	return fh.Name(), nil
+}
+
+// mergeSortedRuns opens all sorted run files and merges them into the emit function.
+func mergeSortedRuns[T any](
+	runs []string,
+	comparator collections.Comparator[T],
+	codec Codec[T],
+	emit func(T) bool,
+) error {
+	iterators := make([]collections.IteratorCloser[T], 0, len(runs))
+	defer func() {
+		for _, iterator := range iterators {
+			_ = iterator.Close()
+		}
+	}()
+	for _, run := range runs {
+		fh, err := os.Open(run)
+		if err != nil {
+			return fmt.Errorf("failed to open sorted run %s (%w)", run, err)
+		}
+		iterators = append(
+			iterators, &runIterator[T]{
+				file:    fh,
+				decoder: codec.NewDecoder(bufio.NewReader(fh)),
+			},
+		)
+	}
+	return mergeIterators(nil, comparator, iterators, emit)
+}
+
+// runIterator reads the elements of a sorted run file.
+type runIterator[T any] struct {
+	file     *os.File
+	decoder  Decoder[T]
+	lastItem *T
+	err      error
+	finished bool
+}
+
+func (r *runIterator[T]) ForEachRemaining(c collections.Consumer[T]) {
+	for r.HasNext() {
+		c(r.Next())
+	}
+}
+
+func (r *runIterator[T]) HasNext() bool {
+	if r.lastItem != nil {
+		return true
+	}
+	if r.finished {
+		return false
+	}
+	item, err := r.decoder.Decode()
+	if err != nil {
+		r.finished = true
+		if !errors.Is(err, io.EOF) {
+			r.err = fmt.Errorf("failed to decode element from %s (%w)", r.file.Name(), err)
+		}
+		return false
+	}
+	r.lastItem = &item
+	return true
+}
+
+func (r *runIterator[T]) Next() T {
+	if !r.HasNext() {
+		if r.err != nil {
+			panic(r.err)
+		}
+		panic(collections.ErrIndexOutOfBounds)
+	}
+	item := *r.lastItem
+	r.lastItem = nil
+	return item
+}
+
+func (r *runIterator[T]) Close() error {
+	r.finished = true
+	return r.file.Close()
+}
@@ -0,0 +1,246 @@
+package stream_test
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/apitalist/collections/stream"
+	"github.com/apitalist/lang"
+)
+
+func ExampleExternalSort() {
+	// Create a directory for the temporary files:
+	tempDir, err := os.MkdirTemp("", "example")
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tempDir)
+	}()
+
+	result := stream.ExternalSort(
+		stream.Of(5, 3, 8, 1, 9, 2, 7),
+		func(a, b int) int {
+			return a - b
+		},
+		stream.ExternalSortConfig[int]{
+			// Only hold 3 elements in memory, everything else is written to temporary files.
+			MaxElementsInMemory: 3,
+			TempDir:             tempDir,
+			Codec:               stream.GobCodec[int](),
+		},
+	).ToSlice()
+	fmt.Println(result)
+
+	// The temporary files are removed after the stream is finished:
+	entries, err := os.ReadDir(tempDir)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(len(entries))
+
+	// Output: [1 2 3 5 7 8 9]
+	// 0
+}
+
+type sortRecord struct {
+	Key  int
+	Name string
+}
+
+func compareSortRecords(a, b sortRecord) int {
+	return a.Key - b.Key
+}
+
+// countingCodec wraps a codec and counts the number of encoders created, which is the number of runs written by
+// ExternalSort. Encoding fails if failEncode is set, and decoding fails if failDecode is set.
+type countingCodec[T any] struct {
+	stream.Codec[T]
+	encoders   int
+	failEncode error
+	failDecode error
+}
+
+func (c *countingCodec[T]) NewEncoder(w io.Writer) stream.Encoder[T] {
+	c.encoders++
+	if c.failEncode != nil {
+		return failingEncoder[T]{c.failEncode}
+	}
+	return c.Codec.NewEncoder(w)
+}
+
+func (c *countingCodec[T]) NewDecoder(r io.Reader) stream.Decoder[T] {
+	if c.failDecode != nil {
+		return failingDecoder[T]{c.failDecode}
+	}
+	return c.Codec.NewDecoder(r)
+}
+
+type failingEncoder[T any] struct {
+	err error
+}
+
+func (f failingEncoder[T]) Encode(T) error {
+	return f.err
+}
+
+type failingDecoder[T any] struct {
+	err error
+}
+
+func (f failingDecoder[T]) Decode() (T, error) {
+	var e T
+	return e, f.err
+}
+
+func TestExternalSortIsStableAcrossRuns(t *testing.T) {
+	codec := &countingCodec[sortRecord]{Codec: stream.GobCodec[sortRecord]()}
+	result := stream.ExternalSort(
+		stream.Of(
+			sortRecord{2, "a"},
+			sortRecord{1, "b"},
+			sortRecord{2, "c"},
+			sortRecord{1, "d"},
+			sortRecord{2, "e"},
+			sortRecord{0, "f"},
+			sortRecord{1, "g"},
+		),
+		compareSortRecords,
+		stream.ExternalSortConfig[sortRecord]{
+			MaxElementsInMemory: 2,
+			TempDir:             t.TempDir(),
+			Codec:               codec,
+		},
+	).ToSlice()
+
+	expected := "[{0 f} {1 b} {1 d} {1 g} {2 a} {2 c} {2 e}]"
+	if fmt.Sprint(result) != expected {
+		t.Fatalf("expected equal elements in input order %s, got %v", expected, result)
+	}
+	if codec.encoders != 4 {
+		t.Fatalf("expected 4 sorted runs, got %d", codec.encoders)
+	}
+}
+
+func TestExternalSortMaxBytesInMemory(t *testing.T) {
+	codec := &countingCodec[string]{Codec: stream.GobCodec[string]()}
+	result := stream.ExternalSort(
+		stream.Of("cccccccc", "a", "bbbbbbbb", "dd", "e", "ffffffff"),
+		strings.Compare,
+		stream.ExternalSortConfig[string]{
+			MaxBytesInMemory: 8,
+			Size: func(s string) uint {
+				return uint(len(s))
+			},
+			TempDir: t.TempDir(),
+			Codec:   codec,
+		},
+	).ToSlice()
+
+	if fmt.Sprint(result) != "[a bbbbbbbb cccccccc dd e ffffffff]" {
+		t.Fatalf("unexpected sort result: %v", result)
+	}
+	// Every large element fills the buffer on its own: [cccccccc], [a bbbbbbbb], [dd e ffffffff].
+	if codec.encoders != 3 {
+		t.Fatalf("expected 3 sorted runs, got %d", codec.encoders)
+	}
+}
+
+func TestExternalSortMaxBytesInMemoryEncodedSize(t *testing.T) {
+	codec := &countingCodec[string]{Codec: stream.GobCodec[string]()}
+	result := stream.ExternalSort(
+		stream.Of(strings.Repeat("c", 100), "a", strings.Repeat("b", 100)),
+		strings.Compare,
+		stream.ExternalSortConfig[string]{
+			// Without a Size function, the size of an element is its encoded size.
+			MaxBytesInMemory: 100,
+			TempDir:          t.TempDir(),
+			Codec:            codec,
+		},
+	).ToSlice()
+
+	if len(result) != 3 || result[0] != "a" {
+		t.Fatalf("unexpected sort result: %v", result)
+	}
+	// One encoder is used to determine the sizes, the rest write the runs.
+	if codec.encoders != 3 {
+		t.Fatalf("expected 2 sorted runs, got %d", codec.encoders-1)
+	}
+}
+
+func TestExternalSortRemovesRunsAfterFindFirst(t *testing.T) {
+	baseline := runtime.NumGoroutine()
+	tempDir := t.TempDir()
+	first := stream.ExternalSort(
+		stream.Of(5, 3, 8, 1, 9, 2, 7),
+		cmp.Compare[int],
+		stream.ExternalSortConfig[int]{
+			MaxElementsInMemory: 2,
+			TempDir:             tempDir,
+		},
+	).FindFirst()
+	if first != 1 {
+		t.Fatalf("expected 1, got %d", first)
+	}
+
+	// The sort stage finishes asynchronously after FindFirst closed the stream.
+	assertGoroutinesReturnToBaseline(t, baseline)
+	entries, err := os.ReadDir(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected the temporary files to be removed, found %d", len(entries))
+	}
+}
+
+func TestExternalSortErrors(t *testing.T) {
+	cause := fmt.Errorf("codec failure")
+	for name, config := range map[string]stream.ExternalSortConfig[int]{
+		"TempDir": {
+			MaxElementsInMemory: 2,
+			TempDir:             filepath.Join(t.TempDir(), "missing"),
+		},
+		"Encode": {
+			MaxElementsInMemory: 2,
+			TempDir:             t.TempDir(),
+			Codec:               &countingCodec[int]{Codec: stream.GobCodec[int](), failEncode: cause},
+		},
+		"Decode": {
+			MaxElementsInMemory: 2,
+			TempDir:             t.TempDir(),
+			Codec:               &countingCodec[int]{Codec: stream.GobCodec[int](), failDecode: cause},
+		},
+	} {
+		t.Run(
+			name, func(t *testing.T) {
+				err := lang.Safe(
+					func() {
+						stream.ExternalSort(stream.Of(5, 3, 8, 1, 9), cmp.Compare[int], config).ToSlice()
+					},
+				)
+				var streamErr stream.StreamError
+				if !errors.As(err, &streamErr) {
+					t.Fatalf("expected a StreamError, got %v", err)
+				}
+				if streamErr.Stage.Name != "ExternalSort" {
+					t.Fatalf("expected the error to be reported by ExternalSort, got %v", err)
+				}
+				if name != "TempDir" && !errors.Is(err, cause) {
+					t.Fatalf("expected the error to wrap the codec failure, got %v", err)
+				}
+				entries, err := os.ReadDir(config.TempDir)
+				if err == nil && len(entries) != 0 {
+					t.Fatalf("expected the temporary files to be removed, found %d", len(entries))
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,224 @@
+package stream
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/apitalist/collections"
+)
+
+// pipeline holds the state shared by all stages of a stream pipeline.
+type pipeline struct {
+	// lock protects the observer and parent fields.
+	lock *sync.Mutex
+	// observer receives the events of all stages in the pipeline. It may be nil.
+	observer Observer
+	// parent is the pipeline the current pipeline feeds into, for example when the current pipeline is the right side
+	// of a join. The observer of the parent is used if the current pipeline has no observer.
+	parent *pipeline
+	// finishOnce makes sure the observer is only notified once about the pipeline finishing.
+	finishOnce *sync.Once
+}
+
+func (p *pipeline) setObserver(observer Observer) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.observer = observer
+}
+
+// getObserver returns the observer of the current pipeline, or the closest parent pipeline with an observer.
+func (p *pipeline) getObserver() Observer {
+	for p != nil {
+		p.lock.Lock()
+		observer, parent := p.observer, p.parent
+		p.lock.Unlock()
+		if observer != nil {
+			return observer
+		}
+		p = parent
+	}
+	return nil
+}
+
+func (p *pipeline) setParent(parent *pipeline) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.parent = parent
+}
+
+func (p *pipeline) finish() {
+	p.finishOnce.Do(
+		func() {
+			if observer := p.getObserver(); observer != nil {
+				observer.Finished()
+			}
+		},
+	)
+}
+
+// stage describes a single stage in a pipeline and reports its events to the observer of the pipeline. All methods
+// can be called on a nil stage, in which case nothing is reported.
+type stage struct {
+	Stage
+
+	// inputCounts holds the number of elements received from each input of the stage, lastInput is the input the last
+	// element was received from, and producedCount is the number of elements the stage emitted. They are used to
+	// determine the position of an element in errors and are only accessed by the goroutine running the stage.
+	inputCounts   []uint
+	lastInput     int
+	producedCount uint
+
+	pipeline *pipeline
+	// hasDownstream is set to 1 once another stage consumes the current stage. It must be accessed atomically.
+	hasDownstream int32
+}
+
+// newStage creates a new stage with the specified name that consumes the upstream stages. Upstream stages may be nil if
+// the upstream stream is not implemented by this package. If there are no known upstream stages, the new stage starts
+// a new pipeline.
+func newStage(name string, upstream ...*stage) *stage {
+	s := &stage{
+		Stage: Stage{
+			Name: name,
+		},
+		inputCounts: make([]uint, len(upstream)),
+	}
+	for _, u := range upstream {
+		if u == nil {
+			continue
+		}
+		atomic.StoreInt32(&u.hasDownstream, 1)
+		if s.pipeline == nil {
+			s.pipeline = u.pipeline
+		} else if u.pipeline != s.pipeline {
+			u.pipeline.setParent(s.pipeline)
+		}
+		if u.Index+1 > s.Index {
+			s.Index = u.Index + 1
+		}
+	}
+	if s.pipeline == nil {
+		s.pipeline = &pipeline{
+			lock:       &sync.Mutex{},
+			finishOnce: &sync.Once{},
+		}
+	}
+	return s
+}
+
+// stageOf returns the stage of the passed stream, or nil if the stream is not implemented by this package.
+func stageOf[T any](s collections.Stream[T]) *stage {
+	if s, ok := s.(*stream[T]); ok {
+		return s.stage
+	}
+	return nil
+}
+
+func (s *stage) observer() Observer {
+	if s == nil {
+		return nil
+	}
+	return s.pipeline.getObserver()
+}
+
+// received reports that the stage received an element from the upstream stage at the specified input index, in the
+// order the upstream stages were passed to newStage.
+func (s *stage) received(input int) {
+	if s == nil {
+		return
+	}
+	if input < len(s.inputCounts) {
+		s.inputCounts[input]++
+		s.lastInput = input
+	}
+	if observer := s.observer(); observer != nil {
+		observer.ElementReceived(s.Stage)
+	}
+}
+
+// emitted reports that the stage passed an element downstream. It is called on the receiving side, so the event is
+// reported before the downstream stage processes the element.
+func (s *stage) emitted() {
+	if observer := s.observer(); observer != nil {
+		observer.ElementEmitted(s.Stage)
+	}
+}
+
+// call runs a user-supplied function, such as a predicate or mapper, and reports the time spent in it.
+func (s *stage) call(f func()) {
+	observer := s.observer()
+	if observer == nil {
+		f()
+		return
+	}
+	start := time.Now()
+	defer func() {
+		observer.FunctionCalled(s.Stage, time.Since(start))
+	}()
+	f()
+}
+
+// produced records that the stage emitted an element. It must be called by the goroutine running the stage.
+func (s *stage) produced() {
+	if s != nil {
+		s.producedCount++
+	}
+}
+
+// position returns the input and the position within that input of the element the stage is currently processing.
+// For stages with inputs, this is the last element received from upstream. For sources, it is the next element to be
+// emitted. It must be called by the goroutine running the stage.
+func (s *stage) position() (uint, uint) {
+	if len(s.inputCounts) == 0 {
+		return 0, s.producedCount
+	}
+	received := s.inputCounts[s.lastInput]
+	if received == 0 {
+		return uint(s.lastInput), 0
+	}
+	return uint(s.lastInput), received - 1
+}
+
+// failed wraps the error in a StreamError describing the stage and reports it. Errors which are already StreamErrors
+// have been passed from upstream and are returned unchanged.
+func (s *stage) failed(err error) error {
+	if s == nil {
+		return err
+	}
+	if _, ok := err.(StreamError); ok {
+		return err
+	}
+	input, position := s.position()
+	err = StreamError{
+		Stage:    s.Stage,
+		Input:    input,
+		Position: position,
+		Cause:    err,
+	}
+	if observer := s.observer(); observer != nil {
+		observer.Failed(s.Stage, err)
+	}
+	return err
+}
+
+// closed reports that the stream of the stage has been closed. If no other stage consumes the current stage, this
+// means the pipeline is finished.
+func (s *stage) closed() {
+	if s != nil && atomic.LoadInt32(&s.hasDownstream) == 0 {
+		s.pipeline.finish()
+	}
+}
+
+// observedComparator wraps the comparator so that the time spent in it is reported to the stage.
+func observedComparator[T any](st *stage, comparator collections.Comparator[T]) collections.Comparator[T] {
+	return func(a, b T) int {
+		var result int
+		st.call(
+			func() {
+				result = comparator(a, b)
+			},
+		)
+		return result
+	}
+}
@@ -1,252 +1,329 @@
+// Package stream provides a channel-based stream processor, which processes individual steps in separate goroutines.
+// However, the individual step executions are not parallelized.
+//
+// Each stream stage runs a goroutine which is stopped when the stream is closed. Streams are closed by terminal
+// functions, by closing the iterator returned from Iterator(), or by calling Close() directly. Streams that become
+// unreachable without being closed are closed by the garbage collector, so abandoned streams do not leak goroutines.
+// However, this may take an arbitrary amount of time, so streams should be closed explicitly whenever possible.
 package stream
 
 import (
+	"errors"
+	"io"
+	"iter"
+	"runtime"
 	"sync"
+	"sync/atomic"
 
 	"github.com/apitalist/collections"
 	"github.com/apitalist/lang"
 )
 
+// newStream creates a new stream for the passed stage. The produce function is run in a separate goroutine and passes elements
+// downstream by calling the emit function. The emit function returns false if the stream has been closed, in which
+// case produce should return. If produce returns an error or panics, the error is passed downstream.
+//
+// The upstream closers are closed when the returned stream is closed or when produce returns. The produce function
+// must not hold a reference to the returned stream, otherwise the stream cannot be closed when it becomes unreachable.
+func newStream[T any](st *stage, produce func(emit func(T) bool) error, upstream ...io.Closer) *stream[T] {
+	output := make(chan T)
+	errorOutput := make(chan error)
+	complete := make(chan struct{})
+	s := &stream[T]{
+		input:      output,
+		errorInput: errorOutput,
+		complete:   complete,
+		closeOnce:  &sync.Once{},
+		lock:       &sync.Mutex{},
+		upstream:   upstream,
+		stage:      st,
+	}
+	go func() {
+		defer func() {
+			for _, u := range upstream {
+				_ = u.Close()
+			}
+			close(output)
+			close(errorOutput)
+		}()
+		emit := func(item T) bool {
+			select {
+			case output <- item:
+				st.produced()
+				return true
+			case <-complete:
+				return false
+			}
+		}
+		var err error
+		if panicErr := lang.Safe(
+			func() {
+				err = produce(emit)
+			},
+		); panicErr != nil {
+			err = panicErr
+		}
+		if err != nil {
+			err = st.failed(err)
+			select {
+			case errorOutput <- err:
+			case <-complete:
+			}
+		}
+	}()
+	runtime.SetFinalizer(
+		s, func(s *stream[T]) {
+			_ = s.Close()
+		},
+	)
+	return s
+}
+
 type stream[T any] struct {
 	// input is a channel where items can be received from upstream.
 	input <-chan T
-	// complete is a channel to signal upstream that processing is complete and no more items should be sent.
+	// complete is a channel to signal upstream that processing is complete and no more items should be sent. It is
+	// closed by Close().
 	complete chan struct{}
+	// closeOnce makes sure the complete channel is only closed once.
+	closeOnce *sync.Once
 	// errorInput is a channel where upstream processors can send errors downstream.
 	errorInput <-chan error
+	// consumed is set to 1 once the stream has been used or closed. It must be accessed atomically.
+	consumed int32
+	// upstream holds the closers that are closed together with the current stream to shut down the upstream stages.
+	upstream []io.Closer
+	// lock protects the closed and onClose fields.
+	lock *sync.Mutex
+	// closed is true once the onClose handlers have been run.
+	closed bool
+	// onClose holds the handlers run when the stream is closed.
+	onClose []func()
+	// stage describes the current stream in its pipeline.
+	stage *stage
 }
 
-func (s stream[T]) AllMatch(p collections.Predicate[T]) bool {
-	for {
-		select {
-		case item, ok := <-s.input:
-			if !ok {
-				return true
-			}
+func (s *stream[T]) AllMatch(p collections.Predicate[T]) bool {
+	result := true
+	s.each(
+		func(item T) bool {
 			if !p(item) {
-				close(s.complete)
-				return false
+				result = false
 			}
-		case err, ok := <-s.errorInput:
-			if !ok {
-				return true
-			}
-			panic(err)
-		}
-	}
+			return result
+		},
+	)
+	return result
 }
 
-func (s stream[T]) AnyMatch(p collections.Predicate[T]) bool {
-	for {
-		select {
-		case item, ok := <-s.input:
-			if !ok {
-				return false
-			}
+func (s *stream[T]) AnyMatch(p collections.Predicate[T]) bool {
+	result := false
+	s.each(
+		func(item T) bool {
 			if p(item) {
-				close(s.complete)
-				return true
+				result = true
 			}
-		case err, ok := <-s.errorInput:
-			if !ok {
-				return true
-			}
-			panic(err)
-		}
-	}
+			return !result
+		},
+	)
+	return result
 }
 
 func (s *stream[T]) Filter(p collections.Predicate[T]) collections.Stream[T] {
-	output := make(chan T)
-	errorOutput := make(chan error)
-	s2 := &stream[T]{
-		input:      output,
-		errorInput: errorOutput,
-		complete:   s.complete,
-	}
-	go func() {
-		defer func() {
-			close(output)
-			close(errorOutput)
-		}()
-		for {
-			var e T
-			var ok bool
-			var err error
-			select {
-			case e, ok = <-s.input:
-				if !ok {
-					return
-				}
-			case err, ok = <-s.errorInput:
-				if !ok {
-					return
-				}
-			case <-s.complete:
-				return
-			}
-			if err != nil {
-				select {
-				case errorOutput <- err:
-					return
-				case <-s.complete:
-					return
-				}
-			}
-			if p(e) {
-				select {
-				case output <- e:
-				case <-s.complete:
-					return
-				}
-			}
-		}
-	}()
-	return s2
+	iterator := s.Iterator()
+	st := newStage("Filter", s.stage)
+	return newStream[T](
+		st,
+		func(emit func(T) bool) error {
+			return consume[T](
+				st, iterator, func(e T) bool {
+					var keep bool
+					st.call(
+						func() {
+							keep = p(e)
+						},
+					)
+					return !keep || emit(e)
+				},
+			)
+		},
+		iterator,
+	)
 }
 
-func (s stream[T]) ToSlice() []T {
-	defer close(s.complete)
+func (s *stream[T]) ToSlice() []T {
 	var result []T
-	for {
-		select {
-		case item, ok := <-s.input:
-			if !ok {
-				return result
-			}
+	s.each(
+		func(item T) bool {
 			result = append(result, item)
-		case err, ok := <-s.errorInput:
-			if !ok {
-				return result
-			}
-			panic(err)
-		}
-	}
+			return true
+		},
+	)
+	return result
 }
 
-func (s stream[T]) FindFirst() T {
-	defer close(s.complete)
-	for {
-		select {
-		case item, ok := <-s.input:
-			if !ok {
-				panic(collections.ErrElementNotFound)
-			}
-			return item
-		case err, ok := <-s.errorInput:
-			// TODO possible race condition here:
-			if !ok {
-				panic(collections.ErrElementNotFound)
-			}
-			panic(err)
-		}
+func (s *stream[T]) FindFirst() T {
+	var result *T
+	s.each(
+		func(item T) bool {
+			result = &item
+			return false
+		},
+	)
+	if result == nil {
+		panic(collections.ErrElementNotFound)
 	}
+	return *result
 }
 
-func (s stream[T]) FindAny() T {
+func (s *stream[T]) FindAny() T {
 	return s.FindFirst()
 }
 
-func (s stream[T]) Count() uint {
-	defer close(s.complete)
+func (s *stream[T]) Count() uint {
 	count := uint(0)
-	for {
-		select {
-		case _, ok := <-s.input:
-			if !ok {
-				return count
-			}
+	s.each(
+		func(_ T) bool {
 			count++
-		case err, ok := <-s.errorInput:
-			if !ok {
-				return count
-			}
-			panic(err)
-		}
-	}
+			return true
+		},
+	)
+	return count
 }
 
 func (s *stream[T]) Map(f func(T) T) collections.Stream[T] {
-	output := make(chan T)
-	errorOutput := make(chan error)
-	s2 := &stream[T]{
-		input:      output,
-		errorInput: errorOutput,
+	iterator := s.Iterator()
+	st := newStage("Map", s.stage)
+	return newStream[T](
+		st,
+		func(emit func(T) bool) error {
+			return consume[T](
+				st, iterator, func(e T) bool {
+					var item T
+					st.call(
+						func() {
+							item = f(e)
+						},
+					)
+					return emit(item)
+				},
+			)
+		},
+		iterator,
+	)
+}
+
+func (s *stream[T]) Iterator() collections.IteratorCloser[T] {
+	s.markConsumed()
+	i := &iterator[T]{
+		stream:     s,
+		input:      s.input,
+		errorInput: s.errorInput,
 		complete:   s.complete,
+		lock:       &sync.Mutex{},
 	}
-	go func() {
-		defer func() {
-			close(output)
-			close(errorOutput)
-		}()
-		for {
-			var e T
-			var ok bool
-			var err error
-			select {
-			case e, ok = <-s.input:
-				if !ok {
-					return
-				}
-			case err, ok = <-s.errorInput:
-				if !ok {
-					return
+	runtime.SetFinalizer(
+		i, func(i *iterator[T]) {
+			_ = i.Close()
+		},
+	)
+	return i
+}
+
+// Close stops the processing of the current stream and all upstream stages, then runs the handlers registered using
+// OnClose. It is safe to call Close multiple times. If a handler panics, the remaining handlers are still run and the
+// first panic is returned as an error.
+func (s *stream[T]) Close() error {
+	atomic.StoreInt32(&s.consumed, 1)
+	var err error
+	s.closeOnce.Do(
+		func() {
+			close(s.complete)
+			for _, u := range s.upstream {
+				if closeErr := u.Close(); closeErr != nil && err == nil {
+					err = closeErr
 				}
-			case <-s.complete:
-				return
 			}
-			if err != nil {
-				select {
-				case errorOutput <- err:
-					return
-				case <-s.complete:
-					return
+			s.lock.Lock()
+			s.closed = true
+			handlers := s.onClose
+			s.onClose = nil
+			s.lock.Unlock()
+			for _, handler := range handlers {
+				if handlerErr := lang.Safe(handler); handlerErr != nil && err == nil {
+					err = handlerErr
 				}
 			}
-			var newItem T
-			err = lang.Safe(
-				func() {
-					newItem = f(e)
-				},
-			)
-			if err != nil {
-				select {
-				case errorOutput <- err:
-					return
-				case <-s.complete:
-					return
-				}
+			s.stage.closed()
+		},
+	)
+	return err
+}
+
+func (s *stream[T]) OnClose(handler func()) collections.Stream[T] {
+	s.lock.Lock()
+	if !s.closed {
+		s.onClose = append(s.onClose, handler)
+		s.lock.Unlock()
+		return s
+	}
+	s.lock.Unlock()
+	handler()
+	return s
+}
+
+func (s *stream[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.each(yield)
+	}
+}
+
+// each reads the elements of the stream and calls f for each element until f returns false. Errors passed from
+// upstream are thrown in a panic. The stream is closed when each returns.
+func (s *stream[T]) each(f func(T) bool) {
+	s.markConsumed()
+	defer func() {
+		_ = s.Close()
+	}()
+	for {
+		select {
+		case item, ok := <-s.input:
+			if !ok {
+				return
 			}
-			select {
-			case output <- newItem:
-			case <-s.complete:
+			s.stage.emitted()
+			if !f(item) {
+				return
+			}
+		case err, ok := <-s.errorInput:
+			if !ok {
 				return
 			}
+			panic(err)
 		}
-	}()
-	return s2
+	}
 }
 
-func (s *stream[T]) Iterator() collections.IteratorCloser[T] {
-	return &iterator[T]{
-		s.input,
-		s.errorInput,
-		s.complete,
-		&sync.Mutex{},
-		nil,
-		nil,
-		false,
+// markConsumed marks the stream as used. If the stream has already been used or closed, an ErrStreamConsumed is thrown
+// in a panic.
+func (s *stream[T]) markConsumed() {
+	if !atomic.CompareAndSwapInt32(&s.consumed, 0, 1) {
+		panic(collections.ErrStreamConsumed)
 	}
 }
 
 type iterator[T any] struct {
+	stream     *stream[T]
 	input      <-chan T
 	errorInput <-chan error
 	complete   chan struct{}
 	lock       *sync.Mutex
 	lastItem   *T
-	lastError  error
-	finished   bool
+	// lastError holds an error received from upstream that has not been returned from Next() yet.
+	lastError error
+	finished  bool
+	// position is the number of elements returned from the iterator so far.
+	position uint
 }
 
 func (i *iterator[T]) ForEachRemaining(c collections.Consumer[T]) {
@@ -256,72 +333,185 @@ func (i *iterator[T]) ForEachRemaining(c collections.Consumer[T]) {
 }
 
 func (i *iterator[T]) Close() error {
+	// The stream is closed without holding the lock so a blocked HasNext() or Next() call is released.
+	err := i.stream.Close()
 	i.lock.Lock()
 	defer i.lock.Unlock()
-	i.finish()
-	return nil
+	i.finished = true
+	return err
 }
 
+// HasNext returns true if there is a next element, or if an error has been received from upstream. In the latter case
+// the next call to Next() throws the error in a panic, so errors are not lost when looping over the iterator.
 func (i *iterator[T]) HasNext() bool {
 	i.lock.Lock()
 	defer i.lock.Unlock()
+	i.fetch()
+	return i.lastItem != nil || i.lastError != nil
+}
+
+func (i *iterator[T]) Next() T {
+	item, ok, err := i.next()
+	if err != nil {
+		panic(err)
+	}
+	if !ok {
+		i.lock.Lock()
+		defer i.lock.Unlock()
+		// The size of a stream is only known once it is exhausted, which is the case here.
+		panic(collections.IndexOutOfBoundsError{Index: int(i.position), Size: i.position})
+	}
+	return item
+}
+
+// next returns the next item without panicking. It returns false if no more items are remaining, or an error if an
+// upstream error was received.
+func (i *iterator[T]) next() (T, bool, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.fetch()
+	var item T
 	if i.lastItem != nil {
-		return true
+		item = *i.lastItem
+		i.lastItem = nil
+		i.position++
+		return item, true, nil
+	}
+	// The error is only returned once, afterwards the iterator behaves as if it had run out of elements.
+	err := i.lastError
+	i.lastError = nil
+	return item, false, err
+}
+
+// fetch receives the next item or error from upstream unless one is already present. It must be called with the lock
+// held.
+func (i *iterator[T]) fetch() {
+	if i.lastItem != nil || i.lastError != nil || i.finished {
+		return
 	}
 	select {
 	case item, ok := <-i.input:
 		if !ok {
 			i.finish()
-			return false
+			return
 		}
+		// The element is reported as emitted on the receiving side so the event is guaranteed to be reported before
+		// the pipeline finishes.
+		i.stream.stage.emitted()
 		i.lastItem = &item
-		return true
 	case err, ok := <-i.errorInput:
-		if !ok {
-			i.finish()
-			return false
+		if ok {
+			i.lastError = err
 		}
-		i.lastError = err
-		return false
+		i.finish()
 	case <-i.complete:
-		return false
+		i.finish()
 	}
 }
 
 func (i *iterator[T]) finish() {
 	if !i.finished {
 		i.finished = true
-		close(i.complete)
+		_ = i.stream.Close()
 	}
 }
 
-func (i *iterator[T]) Next() T {
-	i.lock.Lock()
-	defer i.lock.Unlock()
-	if i.lastError != nil {
-		panic(i.lastError)
+// next fetches the next element from an iterator without panicking. It returns false if there are no more elements
+// remaining, or the error if the iterator passed an error.
+func next[T any](it collections.Iterator[T]) (T, bool, error) {
+	if i, ok := it.(*iterator[T]); ok {
+		return i.next()
 	}
-	if i.lastItem != nil {
-		item := *i.lastItem
-		i.lastItem = nil
-		return item
+	var e T
+	err := lang.Safe(
+		func() {
+			e = it.Next()
+		},
+	)
+	if err != nil {
+		// A StreamError wrapping ErrIndexOutOfBounds is a failure of a stage, not the end of the stream.
+		var streamErr StreamError
+		if errors.Is(err, collections.ErrIndexOutOfBounds) && !errors.As(err, &streamErr) {
+			return e, false, nil
+		}
+		return e, false, err
 	}
-	select {
-	case item, ok := <-i.input:
-		if !ok {
-			i.finish()
-			panic(collections.ErrIndexOutOfBounds)
+	return e, true, nil
+}
+
+// consume reads the elements of an iterator and calls f for each element until f returns false or the iterator runs
+// out of elements. Each element is reported to the passed stage as received from its first input. It returns the error
+// if the iterator passed an error.
+func consume[T any](st *stage, it collections.Iterator[T], f func(T) bool) error {
+	return consumeInput(st, 0, it, f)
+}
+
+// consumeInput works like consume for stages with multiple inputs, reporting the elements as received from the
+// specified input.
+func consumeInput[T any](st *stage, input int, it collections.Iterator[T], f func(T) bool) error {
+	for {
+		e, ok, err := next(it)
+		if err != nil || !ok {
+			return err
 		}
-		return item
-	case err, ok := <-i.errorInput:
-		i.finish()
-		if !ok {
-			panic(collections.ErrIndexOutOfBounds)
+		st.received(input)
+		if !f(e) {
+			return nil
 		}
-		i.lastError = err
+	}
+}
+
+// forEach consumes the passed stream and calls the consumer for each element. This is the base for terminal functions
+// that are implemented outside the stream type. Errors passed from upstream are thrown in a panic.
+func forEach[T any](s collections.Stream[T], c collections.Consumer[T]) {
+	if err := tryForEach(
+		s, func(e T) error {
+			c(e)
+			return nil
+		},
+	); err != nil {
 		panic(err)
-	case <-i.complete:
-		i.finish()
-		panic(collections.ErrIndexOutOfBounds)
 	}
 }
+
+// tryForEach consumes the passed stream and calls f for each element until f returns an error. This is the base for
+// terminal functions that return errors instead of panicking. It returns the first error returned from f, the error
+// passed from upstream, or ErrStreamConsumed if the stream has already been used.
+func tryForEach[T any](s collections.Stream[T], f func(T) error) error {
+	iterator, err := tryIterator(s)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = iterator.Close()
+	}()
+	return tryForEachRemaining[T](iterator, f)
+}
+
+// tryIterator returns the iterator of the passed stream, or ErrStreamConsumed if the stream has already been used.
+func tryIterator[T any](s collections.Stream[T]) (collections.IteratorCloser[T], error) {
+	var iterator collections.IteratorCloser[T]
+	if err := lang.Safe(
+		func() {
+			iterator = s.Iterator()
+		},
+	); err != nil {
+		return nil, err
+	}
+	return iterator, nil
+}
+
+// tryForEachRemaining calls f for each remaining element of the iterator until f returns an error. It returns the
+// first error returned from f or the error passed from upstream. The iterator is not closed.
+func tryForEachRemaining[T any](iterator collections.Iterator[T], f func(T) error) error {
+	var fErr error
+	if err := consume[T](
+		nil, iterator, func(e T) bool {
+			fErr = f(e)
+			return fErr == nil
+		},
+	); err != nil {
+		return err
+	}
+	return fErr
+}
@@ -0,0 +1,66 @@
+package stream
+
+import (
+	"container/heap"
+	"sort"
+
+	"github.com/apitalist/collections"
+)
+
+// TopK returns the k largest elements of the stream according to the comparator, largest first. Only k elements are
+// held in memory regardless of the size of the stream. If the stream has fewer than k elements, all elements are
+// returned.
+//
+// This is a terminal function for the stream.
+func TopK[T any](s collections.Stream[T], k uint, comparator collections.Comparator[T]) []T {
+	h := &topKHeap[T]{
+		comparator: comparator,
+	}
+	forEach(
+		s, func(e T) {
+			switch {
+			case k == 0:
+			case uint(len(h.items)) < k:
+				heap.Push(h, e)
+			case comparator(e, h.items[0]) > 0:
+				h.items[0] = e
+				heap.Fix(h, 0)
+			}
+		},
+	)
+	result := h.items
+	sort.SliceStable(
+		result, func(i, j int) bool {
+			return comparator(result[i], result[j]) > 0
+		},
+	)
+	return result
+}
+
+// topKHeap is a min-heap holding the largest elements seen so far, implementing heap.Interface.
+type topKHeap[T any] struct {
+	items      []T
+	comparator collections.Comparator[T]
+}
+
+func (t *topKHeap[T]) Len() int {
+	return len(t.items)
+}
+
+func (t *topKHeap[T]) Less(i, j int) bool {
+	return t.comparator(t.items[i], t.items[j]) < 0
+}
+
+func (t *topKHeap[T]) Swap(i, j int) {
+	t.items[i], t.items[j] = t.items[j], t.items[i]
+}
+
+func (t *topKHeap[T]) Push(x any) {
+	t.items = append(t.items, x.(T))
+}
+
+func (t *topKHeap[T]) Pop() any {
+	item := t.items[len(t.items)-1]
+	t.items = t.items[:len(t.items)-1]
+	return item
+}
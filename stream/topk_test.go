@@ -0,0 +1,45 @@
+package stream_test
+
+import (
+	"cmp"
+	"fmt"
+	"testing"
+
+	"github.com/apitalist/collections/stream"
+)
+
+func ExampleTopK() {
+	top := stream.TopK(
+		stream.Of(5, 3, 8, 1, 9, 2, 7),
+		3,
+		func(a, b int) int {
+			return a - b
+		},
+	)
+	fmt.Println(top)
+
+	// Output: [9 8 7]
+}
+
+func TestTopKZero(t *testing.T) {
+	if top := stream.TopK(stream.Of(5, 3, 8), 0, cmp.Compare[int]); len(top) != 0 {
+		t.Fatalf("expected no elements for k = 0, got %v", top)
+	}
+}
+
+func TestTopKLargerThanStream(t *testing.T) {
+	top := stream.TopK(stream.Of(5, 3, 8), 10, cmp.Compare[int])
+	if fmt.Sprint(top) != "[8 5 3]" {
+		t.Fatalf("expected all elements largest first, got %v", top)
+	}
+	if top := stream.TopK(stream.Of[int](), 3, cmp.Compare[int]); len(top) != 0 {
+		t.Fatalf("expected no elements for an empty stream, got %v", top)
+	}
+}
+
+func TestTopKDuplicates(t *testing.T) {
+	top := stream.TopK(stream.Of(4, 9, 1, 9, 4, 9, 2, 4), 4, cmp.Compare[int])
+	if fmt.Sprint(top) != "[9 9 9 4]" {
+		t.Fatalf("expected duplicates to be kept, got %v", top)
+	}
+}
@@ -0,0 +1,51 @@
+package stream
+
+import (
+	"errors"
+	"io/fs"
+
+	"github.com/apitalist/collections"
+)
+
+// errWalkStopped is used to stop fs.WalkDir when the stream is closed.
+var errWalkStopped = errors.New("walk stopped")
+
+// WalkEntry is an element of the stream created by WalkDir. It holds the directory entry and its path.
+type WalkEntry struct {
+	fs.DirEntry
+
+	// Path is the path of the entry, starting with the root passed to WalkDir.
+	Path string
+}
+
+// WalkDir creates a stream of the files and directories in the file system tree starting at root, including root
+// itself. The tree is walked lazily using fs.WalkDir in lexical order, and walking stops as soon as the stream is
+// closed, for example when FindFirst finds an element. If an error occurs while walking, it is passed down the
+// stream.
+func WalkDir(fsys fs.FS, root string) collections.Stream[WalkEntry] {
+	return newStream[WalkEntry](
+		newStage("WalkDir"),
+		func(emit func(WalkEntry) bool) error {
+			err := fs.WalkDir(
+				fsys, root, func(path string, d fs.DirEntry, err error) error {
+					if err != nil {
+						return err
+					}
+					if !emit(
+						WalkEntry{
+							DirEntry: d,
+							Path:     path,
+						},
+					) {
+						return errWalkStopped
+					}
+					return nil
+				},
+			)
+			if errors.Is(err, errWalkStopped) {
+				return nil
+			}
+			return err
+		},
+	)
+}
@@ -0,0 +1,112 @@
+package stream_test
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	"github.com/apitalist/collections/stream"
+)
+
+func ExampleWalkDir() {
+	fsys := fstest.MapFS{
+		"docs/readme.md":    {},
+		"docs/guide.md":     {},
+		"src/main.go":       {},
+		"src/main_test.go":  {},
+		"src/util/util.go":  {},
+		"src/util/notes.md": {},
+	}
+
+	goFiles := stream.Map(
+		stream.
+			WalkDir(fsys, "src").
+			Filter(
+				func(e stream.WalkEntry) bool {
+					return !e.IsDir() && path.Ext(e.Path) == ".go"
+				},
+			),
+		func(e stream.WalkEntry) string {
+			return e.Path
+		},
+	).ToSlice()
+	fmt.Println(goFiles)
+
+	// Walking stops as soon as the first markdown file is found:
+	firstMarkdown := stream.
+		WalkDir(fsys, ".").
+		Filter(
+			func(e stream.WalkEntry) bool {
+				return path.Ext(e.Path) == ".md"
+			},
+		).
+		FindFirst()
+	fmt.Println(firstMarkdown.Path)
+
+	// Output: [src/main.go src/main_test.go src/util/util.go]
+	// docs/guide.md
+}
+
+// countingFS records the paths opened or read from the wrapped file system.
+type countingFS struct {
+	fsys fs.ReadDirFS
+	lock sync.Mutex
+	read []string
+}
+
+func (c *countingFS) record(name string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.read = append(c.read, name)
+}
+
+func (c *countingFS) Open(name string) (fs.File, error) {
+	c.record(name)
+	return c.fsys.Open(name)
+}
+
+func (c *countingFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	c.record(name)
+	return c.fsys.ReadDir(name)
+}
+
+func TestWalkDirStopsAfterFindFirst(t *testing.T) {
+	fsys := &countingFS{
+		fsys: fstest.MapFS{
+			"docs/guide.md":     {},
+			"docs/readme.md":    {},
+			"src/main.go":       {},
+			"src/util/util.go":  {},
+			"src/util/notes.md": {},
+		},
+	}
+
+	baseline := runtime.NumGoroutine()
+	first := stream.
+		WalkDir(fsys, ".").
+		Filter(
+			func(e stream.WalkEntry) bool {
+				return path.Ext(e.Path) == ".md"
+			},
+		).
+		FindFirst()
+	if first.Path != "docs/guide.md" {
+		t.Fatalf("unexpected first markdown file: %s", first.Path)
+	}
+
+	// The walk runs in a separate goroutine, wait for it to finish before checking what has been read.
+	assertGoroutinesReturnToBaseline(t, baseline)
+
+	fsys.lock.Lock()
+	defer fsys.lock.Unlock()
+	for _, name := range fsys.read {
+		if name == "src" || strings.HasPrefix(name, "src/") {
+			t.Fatalf("the walk continued into %s after the first element was found (read: %v)", name, fsys.read)
+		}
+	}
+}
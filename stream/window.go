@@ -0,0 +1,140 @@
+package stream
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/apitalist/collections"
+)
+
+// ProcessingTime returns a timestamp function for TumblingWindow and SlidingWindow that assigns each element the time
+// it is processed according to the passed clock, instead of a time stored in the element itself.
+func ProcessingTime[T any](clock Clock) func(T) time.Time {
+	return func(T) time.Time {
+		return clock.Now()
+	}
+}
+
+// TumblingWindow groups the elements of the stream into consecutive, non-overlapping time windows of the specified
+// size and emits the elements of each window as a slice. The time of each element is determined by the timestamp
+// function. If the timestamp function is nil, ProcessingTime with the SystemClock is used. Windows are aligned to
+// multiples of the size since the zero time, and windows without elements are not emitted.
+//
+// Elements are expected in timestamp order. A window is emitted as soon as an element with a timestamp after the end
+// of the window arrives, or when the input stream ends. Late elements belonging only to already emitted windows are
+// dropped.
+func TumblingWindow[T any](
+	s collections.Stream[T],
+	size time.Duration,
+	timestamp func(T) time.Time,
+) collections.Stream[[]T] {
+	return windowStream(s, "TumblingWindow", size, size, timestamp)
+}
+
+// SlidingWindow groups the elements of the stream into time windows of the specified size, starting every slide
+// duration, and emits the elements of each window as a slice. If the slide is smaller than the size, windows overlap
+// and elements are part of multiple windows. The time of each element is determined by the timestamp function. If the
+// timestamp function is nil, ProcessingTime with the SystemClock is used. Windows are aligned to multiples of the
+// slide since the zero time, and windows without elements are not emitted.
+//
+// Elements are expected in timestamp order. A window is emitted as soon as an element with a timestamp after the end
+// of the window arrives, or when the input stream ends. Late elements belonging only to already emitted windows are
+// dropped.
+func SlidingWindow[T any](
+	s collections.Stream[T],
+	size time.Duration,
+	slide time.Duration,
+	timestamp func(T) time.Time,
+) collections.Stream[[]T] {
+	return windowStream(s, "SlidingWindow", size, slide, timestamp)
+}
+
+// windowStream implements TumblingWindow and SlidingWindow in a stage with the specified name.
+func windowStream[T any](
+	s collections.Stream[T],
+	name string,
+	size time.Duration,
+	slide time.Duration,
+	timestamp func(T) time.Time,
+) collections.Stream[[]T] {
+	if size <= 0 || slide <= 0 {
+		panic(fmt.Errorf("the window size and slide must be positive (size: %s, slide: %s)", size, slide))
+	}
+	if timestamp == nil {
+		timestamp = ProcessingTime[T](SystemClock())
+	}
+	iterator := s.Iterator()
+	st := newStage(name, stageOf(s))
+	return newStream[[]T](
+		st,
+		func(emit func([]T) bool) error {
+			var windows []window[T]
+			var emittedUntil time.Time
+			stopped := false
+			if err := consume[T](
+				st, iterator, func(e T) bool {
+					var t time.Time
+					st.call(
+						func() {
+							t = timestamp(e)
+						},
+					)
+					for len(windows) > 0 && !windows[0].end.After(t) {
+						if !emit(windows[0].elements) {
+							stopped = true
+							return false
+						}
+						emittedUntil = windows[0].end
+						windows = windows[1:]
+					}
+					for start := t.Truncate(slide); start.Add(size).After(t); start = start.Add(-slide) {
+						if !start.Add(size).After(emittedUntil) {
+							break
+						}
+						windows = addToWindow(windows, start, size, e)
+					}
+					return true
+				},
+			); err != nil || stopped {
+				return err
+			}
+			for _, w := range windows {
+				if !emit(w.elements) {
+					return nil
+				}
+			}
+			return nil
+		},
+		iterator,
+	)
+}
+
+// window holds the elements of an open time window.
+type window[T any] struct {
+	start    time.Time
+	end      time.Time
+	elements []T
+}
+
+// addToWindow adds the element to the window with the specified start, creating the window if needed. The windows are
+// kept ordered by their start time.
+func addToWindow[T any](windows []window[T], start time.Time, size time.Duration, e T) []window[T] {
+	i := 0
+	for ; i < len(windows); i++ {
+		if windows[i].start.Equal(start) {
+			windows[i].elements = append(windows[i].elements, e)
+			return windows
+		}
+		if windows[i].start.After(start) {
+			break
+		}
+	}
+	windows = append(windows, window[T]{})
+	copy(windows[i+1:], windows[i:])
+	windows[i] = window[T]{
+		start:    start,
+		end:      start.Add(size),
+		elements: []T{e},
+	}
+	return windows
+}
@@ -0,0 +1,102 @@
+package stream_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/apitalist/collections/stream"
+)
+
+type event struct {
+	time  time.Time
+	value int
+}
+
+func events() []event {
+	start := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	return []event{
+		{start, 1},
+		{start.Add(20 * time.Second), 2},
+		{start.Add(70 * time.Second), 3},
+		{start.Add(100 * time.Second), 4},
+		{start.Add(190 * time.Second), 5},
+	}
+}
+
+func eventValues(window []event) []int {
+	result := make([]int, len(window))
+	for i, e := range window {
+		result[i] = e.value
+	}
+	return result
+}
+
+func ExampleTumblingWindow() {
+	windows := stream.TumblingWindow(
+		stream.Of(events()...),
+		time.Minute,
+		func(e event) time.Time {
+			return e.time
+		},
+	).ToSlice()
+
+	for _, w := range windows {
+		fmt.Println(eventValues(w))
+	}
+
+	// Output: [1 2]
+	// [3 4]
+	// [5]
+}
+
+func ExampleSlidingWindow() {
+	windows := stream.SlidingWindow(
+		stream.Of(events()...),
+		time.Minute,
+		30*time.Second,
+		func(e event) time.Time {
+			return e.time
+		},
+	).ToSlice()
+
+	for _, w := range windows {
+		fmt.Println(eventValues(w))
+	}
+
+	// Output: [1 2]
+	// [1 2]
+	// [3]
+	// [3 4]
+	// [4]
+	// [5]
+	// [5]
+}
+
+// fakeClock is a Clock that advances by a fixed step every time it is queried.
+type fakeClock struct {
+	now  time.Time
+	step time.Duration
+}
+
+func (f *fakeClock) Now() time.Time {
+	now := f.now
+	f.now = f.now.Add(f.step)
+	return now
+}
+
+func ExampleProcessingTime() {
+	// Inject a clock to make processing time windows deterministic:
+	clock := &fakeClock{
+		now:  time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC),
+		step: 400 * time.Millisecond,
+	}
+
+	windows := stream.TumblingWindow(
+		stream.Of(1, 2, 3, 4, 5, 6),
+		time.Second,
+		stream.ProcessingTime[int](clock),
+	).ToSlice()
+	fmt.Println(windows)
+
+	// Output: [[1 2 3] [4 5] [6]]
+}